@@ -2,6 +2,7 @@ package ante
 
 import (
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	errorsmod "cosmossdk.io/errors"
@@ -33,6 +34,9 @@ type HandlerOptions struct {
 	// use dynamic fee checker or the cosmos-sdk default one for native transactions
 	DynamicFeeChecker bool
 	PendingTxListener PendingTxListener
+	// FeeDenomOracle optionally converts the fees of Cosmos transactions paid in
+	// other denoms to the EVM denom when enforcing the minimum gas price
+	FeeDenomOracle evmtypes.FeeDenomOracle
 }
 
 // Validate checks if the keepers are defined
@@ -83,7 +87,9 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			if len(opts) > 0 {
 				switch typeURL := opts[0].GetTypeUrl(); typeURL {
 				case "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx":
-					// handle as *evmtypes.MsgEthereumTx
+					// handle as *evmtypes.MsgEthereumTx, the fee payment and
+					// refund are part of the EVM transaction
+					ctx = evmtypes.WithEVMExecution(ctx)
 					anteHandler = newMonoEVMAnteHandler(ctx, options)
 				case "/cosmos.evm.ante.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
@@ -19,15 +19,18 @@ import (
 // as the MinGasPrices param. If fee is too low, decorator returns error and tx
 // is rejected. This applies for both CheckTx and DeliverTx
 // If fee is high enough, then call next AnteHandler
+// The fees paid in the denoms accepted by the fee denom oracle, if any, are
+// converted to the EVM denom.
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
 	feemarketParams *feemarkettypes.Params
+	feeDenomOracle  evmtypes.FeeDenomOracle
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
-// Cosmos transactions.
-func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feemarketParams}
+// Cosmos transactions. The fee denom oracle is optional.
+func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params, feeDenomOracle evmtypes.FeeDenomOracle) MinGasPriceDecorator {
+	return MinGasPriceDecorator{feemarketParams, feeDenomOracle}
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -41,13 +44,15 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	feeCoins := feeTx.GetFee()
 	evmDenom := evmtypes.GetEVMCoinDenom()
 
-	// only allow user to pass in aatom and stake native token as transaction fees
+	// only allow user to pass in aatom and stake native token as transaction fees,
+	// or a single denom accepted by the fee denom oracle
 	// allow use stake native tokens for fees is just for unit tests to pass
 	//
 	// TODO: is the handling of stake necessary here? Why not adjust the tests to contain the correct denom?
-	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 &&
+		(slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)) || mpd.isOracleDenom(ctx, feeCoins[0])))
 	if !validFees && !simulate {
-		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
+		return ctx, fmt.Errorf("expected only native token %s for fee, or a fee denom accepted by the oracle, but got %s", evmDenom, feeCoins.String())
 	}
 
 	// Short-circuit if min gas price is 0 or if simulating
@@ -84,7 +89,7 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			requiredFees)
 	}
 
-	if !feeCoins.IsAnyGTE(requiredFees) {
+	if !feeCoins.IsAnyGTE(requiredFees) && !mpd.isOracleFeeGTE(ctx, feeCoins, requiredFees) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the gas price.",
 			feeCoins,
@@ -93,3 +98,23 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	return next(ctx, tx, simulate)
 }
+
+// isOracleDenom returns true if the denom of the coin is accepted for fees by the
+// fee denom oracle.
+func (mpd MinGasPriceDecorator) isOracleDenom(ctx sdk.Context, coin sdk.Coin) bool {
+	if mpd.feeDenomOracle == nil {
+		return false
+	}
+	_, ok := mpd.feeDenomOracle.ConvertToEVMDenom(ctx, coin)
+	return ok
+}
+
+// isOracleFeeGTE returns true if the fees converted to the EVM denom by the fee
+// denom oracle are at least the required fees in the EVM denom.
+func (mpd MinGasPriceDecorator) isOracleFeeGTE(ctx sdk.Context, feeCoins, requiredFees sdk.Coins) bool {
+	if mpd.feeDenomOracle == nil {
+		return false
+	}
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	return evmtypes.FeeAmountInEVMDenom(ctx, mpd.feeDenomOracle, evmDenom, feeCoins).GTE(requiredFees.AmountOf(evmDenom))
+}
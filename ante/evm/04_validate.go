@@ -1,9 +1,11 @@
 package evm
 
 import (
+	"bytes"
 	"errors"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
@@ -88,8 +90,11 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// NOTE: the fee granter is allowed so that the fees can be paid with a
+	// fee allowance, see CheckFeeGranter. The fee payer is always the sender
+	// of the eth tx.
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
@@ -100,6 +105,26 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// CheckFeeGranter checks that the fee granter of an eth tx, if it sets one,
+// is the recipient of the tx. The fee granter is not covered by the signature
+// of the eth tx, binding it to the signed recipient prevents anyone relaying
+// the tx from spending the allowances given to the sender by other granters.
+func CheckFeeGranter(feeGranter sdktypes.AccAddress, ethTx *ethtypes.Transaction) error {
+	if feeGranter.Empty() {
+		return nil
+	}
+
+	to := ethTx.To()
+	if to == nil || !bytes.Equal(feeGranter, to.Bytes()) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"for eth tx AuthInfo Fee granter %s should be the recipient of the tx", common.BytesToAddress(feeGranter),
+		)
+	}
+
+	return nil
+}
+
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
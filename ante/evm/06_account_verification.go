@@ -28,13 +28,69 @@ func VerifyAccountBalance(
 	from common.Address,
 	ethTx *ethtypes.Transaction,
 ) error {
+	account, err := verifySenderAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifyGranteeAccountBalance is the counterpart of VerifyAccountBalance for
+// transactions whose fees are paid by a fee granter, so the account balance
+// only needs to cover the value of the transaction.
+func VerifyGranteeAccountBalance(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+) error {
+	account, err := verifySenderAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	value := ethTx.Value()
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if account.Balance.ToBig().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// verifySenderAccount checks that the sender is an EOA and sets its account to
+// store if it doesn't exist. It returns the sender account, which is empty if
+// it didn't exist.
+func verifySenderAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.HasCodeHash() {
 		// check eip-7702
 		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
 		_, delegated := ethtypes.ParseDelegation(code)
 		if len(code) > 0 && !delegated {
-			return errorsmod.Wrapf(
+			return nil, errorsmod.Wrapf(
 				errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s", from,
 			)
@@ -46,9 +102,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
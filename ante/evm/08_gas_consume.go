@@ -14,6 +14,7 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // UpdateCumulativeGasWanted updates the cumulative gas wanted
@@ -61,6 +62,35 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// UseGrantedFees checks that the fee granter allows paying the fees of the
+// sender for the given messages and updates the fee allowance. The fees are
+// checked in the extended denom, since it is the denom they are deducted in.
+// The allowance is used up for the gas limit, the refund of the leftover gas
+// only goes to the granter balance.
+func UseGrantedFees(
+	ctx sdktypes.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	fees sdktypes.Coins,
+	feeGranter sdktypes.AccAddress,
+	from sdktypes.AccAddress,
+	msgs []sdktypes.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	if feeGranter.Equals(from) {
+		return nil
+	}
+
+	convertedFees := evmtypes.ConvertCoinsDenomToExtendedDenom(fees)
+	if err := feegrantKeeper.UseGrantedFees(ctx, feeGranter, from, convertedFees, msgs); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+	}
+
+	return nil
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
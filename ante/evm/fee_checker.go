@@ -2,6 +2,7 @@ package evm
 
 import (
 	"math"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/params"
 
@@ -25,7 +26,9 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
+// The fees paid in the denoms accepted by the fee denom oracle, if any, are
+// valued in the EVM denom and deducted in full.
+func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params, feeDenomOracle evmtypes.FeeDenomOracle) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -40,15 +43,18 @@ func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFee
 		denom := evmtypes.GetEVMCoinDenom()
 		ethCfg := evmtypes.GetEthChainConfig()
 
-		return FeeChecker(ctx, feemarketParams, denom, ethCfg, feeTx)
+		return FeeChecker(ctx, feemarketParams, denom, feeDenomOracle, ethCfg, feeTx)
 	}
 }
 
 // FeeChecker returns the effective fee and priority for a given transaction.
+// The fees paid in other denoms than the EVM denom are valued by the fee denom
+// oracle, if any, and the effective fee is then the whole fee of the tx.
 func FeeChecker(
 	ctx sdk.Context,
 	feemarketParams *feemarkettypes.Params,
 	denom string,
+	feeDenomOracle evmtypes.FeeDenomOracle,
 	ethConfig *params.ChainConfig,
 	feeTx sdk.FeeTx,
 ) (sdk.Coins, int64, error) {
@@ -92,7 +98,7 @@ func FeeChecker(
 	}
 
 	feeCoins := feeTx.GetFee()
-	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeCoins.AmountOfNoDenomValidation(denom))
+	feeAmtDec := sdkmath.LegacyNewDecFromInt(evmtypes.FeeAmountInEVMDenom(ctx, feeDenomOracle, denom, feeCoins))
 
 	feeCap := feeAmtDec.QuoInt(gas)
 	if feeCap.LT(baseFee) {
@@ -109,6 +115,10 @@ func FeeChecker(
 			Amount: effectivePrice.MulInt(gas).Ceil().RoundInt(),
 		},
 	}
+	// the fees paid in the oracle denoms cannot be split, they are deducted in full
+	if feeDenomOracle != nil && slices.ContainsFunc(feeCoins, func(coin sdk.Coin) bool { return coin.Denom != denom }) {
+		effectiveFee = feeCoins
+	}
 	priorityInt := effectivePrice.Sub(baseFee).QuoInt(evmtypes.DefaultPriorityReduction).TruncateInt()
 	priority := int64(math.MaxInt64)
 
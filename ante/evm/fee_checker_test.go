@@ -24,6 +24,16 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
+// mockFeeDenomOracle values a unit of uusdc as three units of the EVM denom.
+type mockFeeDenomOracle struct{}
+
+func (mockFeeDenomOracle) ConvertToEVMDenom(_ sdk.Context, coin sdk.Coin) (math.Int, bool) {
+	if coin.Denom != "uusdc" {
+		return math.Int{}, false
+	}
+	return coin.Amount.MulRaw(3), true
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -34,6 +44,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 	//      with extension option
 	//      without extension option
 	//      london hardfork enableness
+	//      fee denom accepted by the oracle
 	chainID := uint64(config.EighteenDecimalsChainID)
 	encodingConfig := encoding.MakeConfig(chainID) //nolint:staticcheck // this is used
 
@@ -223,6 +234,60 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee paid in an oracle denom",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				return feemarketParams
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"10000000uusdc",
+			29,
+			true,
+		},
+		{
+			"fail, dynamic fee paid in an oracle denom below the base fee",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				return feemarketParams
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(3))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee paid in a denom not accepted by the oracle",
+			deliverTxCtx,
+			func() feemarkettypes.Params {
+				feemarketParams.BaseFee = math.LegacyNewDec(10)
+				return feemarketParams
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(10).Mul(evmtypes.DefaultPriorityReduction))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -259,7 +324,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 				cfg.LondonBlock = big.NewInt(0)
 			}
 			feemarketParams := tc.feemarketParamsFn()
-			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams, mockFeeDenomOracle{})(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
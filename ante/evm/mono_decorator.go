@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 const AcceptedTxType = 0 |
@@ -33,6 +34,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
 	evmParams       *evmtypes.Params
 	feemarketParams *feemarkettypes.Params
@@ -44,10 +46,14 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The fee grant keeper is optional, the transactions setting a fee granter
+// are rejected if it is nil.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 	evmParams *evmtypes.Params,
 	feemarketParams *feemarkettypes.Params,
@@ -56,6 +62,7 @@ func NewEVMMonoDecorator(
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 		evmParams:       evmParams,
 		feemarketParams: feemarketParams,
@@ -174,12 +181,26 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// The fees are paid by the fee granter of the tx if it sets one, which
+	// must be the recipient of the tx, e.g. a dapp sponsoring its users.
+	var feeGranter sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+	if err := CheckFeeGranter(feeGranter, ethTx); err != nil {
+		return ctx, err
+	}
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	verifyAccountBalance := VerifyAccountBalance
+	if !feeGranter.Empty() {
+		verifyAccountBalance = VerifyGranteeAccountBalance
+	}
+	if err := verifyAccountBalance(
 		ctx,
 		md.evmKeeper,
 		md.accountKeeper,
@@ -217,11 +238,29 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	feePayer := from
+	if !feeGranter.Empty() {
+		if err := UseGrantedFees(
+			ctx,
+			md.feegrantKeeper,
+			msgFees,
+			feeGranter,
+			from,
+			tx.GetMsgs(),
+		); err != nil {
+			return ctx, err
+		}
+		feePayer = feeGranter
+	}
+
+	// the fee granter is stored so that the leftover gas is refunded to it
+	md.evmKeeper.SetFeeGranterTransient(ctx, feeGranter)
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
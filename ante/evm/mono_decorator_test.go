@@ -62,7 +62,8 @@ func (k *ExtendedEVMKeeper) SpendableCoin(ctx sdk.Context, addr common.Address)
 	return uint256.NewInt(0)
 }
 
-func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
+func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context)                    {}
+func (k *ExtendedEVMKeeper) SetFeeGranterTransient(_ sdk.Context, _ sdk.AccAddress) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
@@ -219,7 +220,7 @@ func TestMonoDecorator(t *testing.T) {
 			feeMarketKeeper := MockFeeMarketKeeper{}
 			params := keeper.GetParams(sdk.Context{})
 			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0, &params, &feemarketParams)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, nil, 0, &params, &feemarketParams)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 
@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/evm/mempool/v1/query.proto
+
+package mempoolv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Status_FullMethodName            = "/cosmos.evm.mempool.v1.Query/Status"
+	Query_CosmosContent_FullMethodName     = "/cosmos.evm.mempool.v1.Query/CosmosContent"
+	Query_CosmosContentFrom_FullMethodName = "/cosmos.evm.mempool.v1.Query/CosmosContentFrom"
+	Query_NextBlock_FullMethodName         = "/cosmos.evm.mempool.v1.Query/NextBlock"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Status queries the number of transactions of the EVM and Cosmos pools.
+	Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// CosmosContent queries the Cosmos transactions of the mempool.
+	CosmosContent(ctx context.Context, in *QueryCosmosContentRequest, opts ...grpc.CallOption) (*QueryCosmosContentResponse, error)
+	// CosmosContentFrom queries the Cosmos transactions of the mempool signed by
+	// an address.
+	CosmosContentFrom(ctx context.Context, in *QueryCosmosContentFromRequest, opts ...grpc.CallOption) (*QueryCosmosContentFromResponse, error)
+	// NextBlock queries the transactions predicted to be selected in the next
+	// block.
+	NextBlock(ctx context.Context, in *QueryNextBlockRequest, opts ...grpc.CallOption) (*QueryNextBlockResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, Query_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CosmosContent(ctx context.Context, in *QueryCosmosContentRequest, opts ...grpc.CallOption) (*QueryCosmosContentResponse, error) {
+	out := new(QueryCosmosContentResponse)
+	err := c.cc.Invoke(ctx, Query_CosmosContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CosmosContentFrom(ctx context.Context, in *QueryCosmosContentFromRequest, opts ...grpc.CallOption) (*QueryCosmosContentFromResponse, error) {
+	out := new(QueryCosmosContentFromResponse)
+	err := c.cc.Invoke(ctx, Query_CosmosContentFrom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextBlock(ctx context.Context, in *QueryNextBlockRequest, opts ...grpc.CallOption) (*QueryNextBlockResponse, error) {
+	out := new(QueryNextBlockResponse)
+	err := c.cc.Invoke(ctx, Query_NextBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Status queries the number of transactions of the EVM and Cosmos pools.
+	Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// CosmosContent queries the Cosmos transactions of the mempool.
+	CosmosContent(context.Context, *QueryCosmosContentRequest) (*QueryCosmosContentResponse, error)
+	// CosmosContentFrom queries the Cosmos transactions of the mempool signed by
+	// an address.
+	CosmosContentFrom(context.Context, *QueryCosmosContentFromRequest) (*QueryCosmosContentFromResponse, error)
+	// NextBlock queries the transactions predicted to be selected in the next
+	// block.
+	NextBlock(context.Context, *QueryNextBlockRequest) (*QueryNextBlockResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedQueryServer) CosmosContent(context.Context, *QueryCosmosContentRequest) (*QueryCosmosContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosContent not implemented")
+}
+func (UnimplementedQueryServer) CosmosContentFrom(context.Context, *QueryCosmosContentFromRequest) (*QueryCosmosContentFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosmosContentFrom not implemented")
+}
+func (UnimplementedQueryServer) NextBlock(context.Context, *QueryNextBlockRequest) (*QueryNextBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBlock not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Status(ctx, req.(*QueryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CosmosContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CosmosContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosContent(ctx, req.(*QueryCosmosContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CosmosContentFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCosmosContentFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CosmosContentFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CosmosContentFrom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CosmosContentFrom(ctx, req.(*QueryCosmosContentFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextBlock(ctx, req.(*QueryNextBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _Query_Status_Handler,
+		},
+		{
+			MethodName: "CosmosContent",
+			Handler:    _Query_CosmosContent_Handler,
+		},
+		{
+			MethodName: "CosmosContentFrom",
+			Handler:    _Query_CosmosContentFrom_Handler,
+		},
+		{
+			MethodName: "NextBlock",
+			Handler:    _Query_NextBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/mempool/v1/query.proto",
+}
@@ -0,0 +1,100 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var flagJSONRPC = "json-rpc"
+
+// Cmd creates the mempool CLI command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mempool",
+		Short: "Managing the mempool of a node",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CancelCosmosTxCmd(),
+	)
+
+	return cmd
+}
+
+// CancelCosmosTxCmd creates the command cancelling a Cosmos transaction in the
+// mempool of a node through its JSON-RPC server.
+func CancelCosmosTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-cosmos-tx [address] [sequence]",
+		Short: "Cancel a Cosmos transaction in the mempool of a node",
+		Long: `Remove the Cosmos transaction of the signer with the sequence from the mempool of the node, along with the following transactions of the signer.
+The address is either a bech32 or a hex address. The node must enable the miner namespace of its JSON-RPC server
+and the cancellation with json-rpc.enable-cosmos-tx-cancel, which is restricted to its operator.
+The cancellation is local to the node: the other nodes may still include the transactions they received.`,
+		Example: fmt.Sprintf("$ %s mempool cancel-cosmos-tx 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E 5 --%s http://%s", version.AppName, flagJSONRPC, config.DefaultJSONRPCAddress),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := parseAddress(args[0])
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[1], err)
+			}
+			endpoint, err := cmd.Flags().GetString(flagJSONRPC)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			rpcClient, err := rpc.DialContext(ctx, endpoint)
+			if err != nil {
+				return fmt.Errorf("failed to dial JSON-RPC server %s: %w", endpoint, err)
+			}
+			defer rpcClient.Close()
+
+			var cancelled []*types.RPCCosmosTransaction
+			if err := rpcClient.CallContext(ctx, &cancelled, "miner_cancelCosmosTransaction", address, hexutil.Uint64(sequence)); err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(cancelled, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagJSONRPC, "http://"+config.DefaultJSONRPCAddress, "The JSON-RPC endpoint of the node")
+	return cmd
+}
+
+// parseAddress parses a bech32 or hex address.
+func parseAddress(addr string) (common.Address, error) {
+	if common.IsHexAddress(addr) {
+		return common.HexToAddress(addr), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid address %s, expected a bech32 or hex address: %w", addr, err)
+	}
+	return common.BytesToAddress(accAddr), nil
+}
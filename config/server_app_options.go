@@ -4,9 +4,11 @@ import (
 	"math"
 	"path/filepath"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
 
@@ -139,10 +141,76 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime != 0 {
 		legacyConfig.Lifetime = lifetime
 	}
+	if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" {
+		if !filepath.IsAbs(journal) {
+			homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+			journal = filepath.Join(homeDir, "data", journal)
+		}
+		legacyConfig.Journal = journal
+	}
+	if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal != 0 {
+		legacyConfig.Rejournal = rejournal
+	}
+	if maxNonceGap := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxNonceGap)); maxNonceGap != 0 {
+		legacyConfig.MaxNonceGap = maxNonceGap
+	}
+	legacyConfig.RequireQueuedFunds = cast.ToBool(appOpts.Get(srvflags.EVMMempoolRequireQueuedFunds))
+	legacyConfig.GapEviction = cast.ToBool(appOpts.Get(srvflags.EVMMempoolGapEviction))
+	for _, local := range cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolLocals)) {
+		if !common.IsHexAddress(local) {
+			logger.Error("invalid local address in app.toml or flag, ignoring it", "address", local)
+			continue
+		}
+		legacyConfig.Locals = append(legacyConfig.Locals, common.HexToAddress(local))
+	}
 
 	return &legacyConfig
 }
 
+// GetSelectionPolicy reads the block building policy of the mempool from appOpts,
+// defaulting to the selection by effective tip.
+func GetSelectionPolicy(appOpts servertypes.AppOptions, logger log.Logger) evmmempool.SelectionPolicy {
+	if appOpts == nil {
+		logger.Error("app options is nil, using default selection policy")
+		return evmmempool.TipSelectionPolicy{}
+	}
+
+	var policy evmmempool.SelectionPolicy
+	switch name := cast.ToString(appOpts.Get(srvflags.EVMMempoolSelectionPolicy)); name {
+	case "", evmmempool.SelectionPolicyTip:
+		policy = evmmempool.TipSelectionPolicy{}
+	case evmmempool.SelectionPolicyLanes:
+		policy = evmmempool.LaneSelectionPolicy{
+			EVMWeight:    cast.ToUint64(appOpts.Get(srvflags.EVMMempoolEVMLaneWeight)),
+			CosmosWeight: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosLaneWeight)),
+		}
+	default:
+		logger.Error("invalid selection policy in app.toml or flag, using default selection policy", "policy", name)
+		policy = evmmempool.TipSelectionPolicy{}
+	}
+
+	if reservedBlockSpace := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolReservedBlockSpace)); reservedBlockSpace != 0 {
+		policy = evmmempool.ReservedSpaceSelectionPolicy{
+			Base:            policy,
+			ReservedPercent: reservedBlockSpace,
+			MsgTypeURLs:     cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolReservedMsgTypes)),
+		}
+	}
+
+	return policy
+}
+
+// GetCosmosPriceBump reads the minimum priority bump percentage to replace a Cosmos
+// transaction from appOpts, zero to use the default of the mempool.
+func GetCosmosPriceBump(appOpts servertypes.AppOptions, logger log.Logger) uint64 {
+	if appOpts == nil {
+		logger.Error("app options is nil, using default cosmos price bump")
+		return 0
+	}
+
+	return cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosPriceBump))
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		logger.Error("app options is nil, using default cosmos pool max tx of -1 (no-op)")
@@ -7,9 +7,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	srvflags "github.com/cosmos/evm/server/flags"
+
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
@@ -330,3 +334,62 @@ func createInvalidGenesis(t *testing.T) string {
 
 	return tempDir
 }
+
+func TestGetLegacyPoolConfigJournal(t *testing.T) {
+	t.Parallel()
+
+	local := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	tests := []struct {
+		name         string
+		setupFn      func() servertypes.AppOptions
+		expJournal   string
+		expRejournal time.Duration
+		expLocals    []common.Address
+	}{
+		{
+			name: "journal disabled by default",
+			setupFn: func() servertypes.AppOptions {
+				return newMockAppOptions()
+			},
+			expJournal:   "",
+			expRejournal: time.Hour,
+		},
+		{
+			name: "relative journal in the data directory",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/node")
+				opts.Set(srvflags.EVMMempoolJournal, "transactions.rlp")
+				opts.Set(srvflags.EVMMempoolRejournal, "10m")
+				return opts
+			},
+			expJournal:   "/node/data/transactions.rlp",
+			expRejournal: 10 * time.Minute,
+		},
+		{
+			name: "absolute journal and locals",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/node")
+				opts.Set(srvflags.EVMMempoolJournal, "/journal/transactions.rlp")
+				opts.Set(srvflags.EVMMempoolLocals, []string{local.Hex(), "invalid"})
+				return opts
+			},
+			expJournal:   "/journal/transactions.rlp",
+			expRejournal: time.Hour,
+			expLocals:    []common.Address{local},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := GetLegacyPoolConfig(tc.setupFn(), log.NewNopLogger())
+			require.Equal(t, tc.expJournal, config.Journal)
+			require.Equal(t, tc.expRejournal, config.Rejournal)
+			require.Equal(t, tc.expLocals, config.Locals)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Grant defines an authorization given by a granter to a grantee.
+struct Grant {
+    /// @dev Address of the account giving the authorization
+    address granter;
+    /// @dev Address of the account receiving the authorization
+    address grantee;
+    /// @dev Type URL of the authorization, e.g. /cosmos.authz.v1beta1.GenericAuthorization
+    string authorizationType;
+    /// @dev Type URL of the message the grantee is allowed to execute
+    string msgTypeUrl;
+    /// @dev Coins the grantee can still send, only set for send authorizations
+    Coin[] spendLimit;
+    /// @dev Accounts the grantee can send coins to, only set for send authorizations
+    address[] allowList;
+    /// @dev Unix time at which the grant expires, zero if it does not expire
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the authz module
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted.
+    /// @param granter The address of the account giving the authorization
+    /// @param grantee The address of the account receiving the authorization
+    /// @param msgTypeUrl The type URL of the authorized message
+    event AuthorizationGranted(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the account that gave the authorization
+    /// @param grantee The address of the account that received the authorization
+    /// @param msgTypeUrl The type URL of the message that is no longer authorized
+    event AuthorizationRevoked(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Emitted when messages are executed on behalf of their signers.
+    /// @param grantee The address of the account executing the messages
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event AuthorizationExecuted(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev Grants the grantee the permission to execute any message of the
+    /// given type on behalf of the granter.
+    /// @param granter The address of the account giving the authorization, must be the caller
+    /// @param grantee The address of the account receiving the authorization
+    /// @param msgTypeUrl The type URL of the authorized message, e.g. /cosmos.gov.v1.MsgVote
+    /// @param expiration The unix time at which the grant expires, zero for no expiration
+    /// @return success Whether the authorization was granted
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants the grantee the permission to send coins of the granter,
+    /// up to the spend limit.
+    /// @param granter The address of the account giving the authorization, must be the caller
+    /// @param grantee The address of the account receiving the authorization
+    /// @param spendLimit The coins the grantee can send
+    /// @param allowList The accounts the grantee can send coins to, empty for any account
+    /// @param expiration The unix time at which the grant expires, zero for no expiration
+    /// @return success Whether the authorization was granted
+    function grantSend(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the authorization of the grantee for the given message type.
+    /// @param granter The address of the account that gave the authorization, must be the caller
+    /// @param grantee The address of the account that received the authorization
+    /// @param msgTypeUrl The type URL of the message to revoke the authorization for
+    /// @return success Whether the authorization was revoked
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes Cosmos messages on behalf of the accounts that granted
+    /// the grantee an authorization for them.
+    /// @param grantee The address of the account executing the messages, must be the caller
+    /// @param msgs The JSON encoded messages, each with its @type field
+    /// @return results The results of the executed messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev Returns the grants of a granter to a grantee.
+    /// @param granter The address of the account giving the authorizations
+    /// @param grantee The address of the account receiving the authorizations
+    /// @param msgTypeUrl The type URL of the authorized message, empty for all types
+    /// @param pagination The pagination options
+    /// @return grants The grants of the granter to the grantee
+    /// @return pageResponse The pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants given by a granter.
+    /// @param granter The address of the account giving the authorizations
+    /// @param pagination The pagination options
+    /// @return grants The grants given by the granter
+    /// @return pageResponse The pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants received by a grantee.
+    /// @param grantee The address of the account receiving the authorizations
+    /// @param pagination The pagination options
+    /// @return grants The grants received by the grantee
+    /// @return pageResponse The pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+}
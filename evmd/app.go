@@ -27,6 +27,7 @@ import (
 	evmmempool "github.com/cosmos/evm/mempool"
 	precompiletypes "github.com/cosmos/evm/precompiles/types"
 	srvflags "github.com/cosmos/evm/server/flags"
+	"github.com/cosmos/evm/statemirror"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/erc20"
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
@@ -47,6 +48,11 @@ import (
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -182,9 +188,10 @@ type EVMD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
@@ -192,6 +199,7 @@ type EVMD struct {
 	Erc20Keeper       erc20keeper.Keeper
 	PreciseBankKeeper precisebankkeeper.Keeper
 	EVMMempool        *evmmempool.ExperimentalEVMMempool
+	StateMirror       *statemirror.StateMirror
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -240,7 +248,7 @@ func NewExampleApp(
 		govtypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
 	)
@@ -353,7 +361,9 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	// the bank keeper is needed to create the accounts of the new grantees
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).
+		SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -366,7 +376,7 @@ func NewExampleApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-	)
+	).SetBankKeeper(app.BankKeeper)
 
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
@@ -438,6 +448,18 @@ func NewExampleApp(
 		app.AccountKeeper,
 	)
 
+	// NOTE: the ICS4Wrapper of the ICA controller keeper is set after the
+	// creation of the ICA controller stack below.
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		nil,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
 	// Set up EVM keeper
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 
@@ -455,7 +477,7 @@ func NewExampleApp(
 		evmChainID,
 		tracer,
 	).WithStaticPrecompiles(
-		precompiletypes.DefaultStaticPrecompiles(
+		precompiletypes.StaticPrecompiles(precompiletypes.DefaultStaticPrecompiles(
 			*app.StakingKeeper,
 			app.DistrKeeper,
 			app.PreciseBankKeeper,
@@ -465,7 +487,11 @@ func NewExampleApp(
 			app.GovKeeper,
 			app.SlashingKeeper,
 			appCodec,
-		),
+		)).
+			WithVestingPrecompile(app.AccountKeeper, app.PreciseBankKeeper).
+			WithAuthzPrecompile(app.AuthzKeeper, app.PreciseBankKeeper, appCodec).
+			WithFeegrantPrecompile(app.FeeGrantKeeper, app.PreciseBankKeeper).
+			WithICS27Precompile(&app.ICAControllerKeeper, app.PreciseBankKeeper),
 	)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
@@ -479,6 +505,11 @@ func NewExampleApp(
 		&app.TransferKeeper,
 	)
 
+	// emit ERC-20 Transfer logs for the movements of the native token pairs made outside of the EVM
+	if cast.ToBool(appOpts.Get(srvflags.EVMEnableTransferLogs)) {
+		app.BankKeeper.AppendSendRestriction(app.Erc20Keeper.TransferLogSendRestriction)
+	}
+
 	// instantiate IBC transfer keeper AFTER the ERC-20 keeper to use it in the instantiation
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec,
@@ -521,13 +552,38 @@ func NewExampleApp(
 	)
 	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
 
+	/*
+		Create Interchain Accounts Controller Stack
+
+		ICA controller stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ICA Controller
+
+		SendPacket, since it is originating from the application to core IBC:
+			icaControllerKeeper.SendTx -> callbacks.SendPacket -> channel.SendPacket
+
+		Acknowledgements and timeouts are delivered to the owner contract through the callbacks middleware:
+			channel.AcknowledgePacket -> callbacks.OnAcknowledgementPacket -> icaController.OnAcknowledgementPacket
+	*/
+	var icaControllerStack porttypes.IBCModule
+
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	icaICS4Wrapper, ok := icaControllerStack.(porttypes.ICS4Wrapper)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", icaControllerStack, icaICS4Wrapper))
+	}
+	app.ICAControllerKeeper.WithICS4Wrapper(icaICS4Wrapper)
+
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer and ICA controller routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -568,6 +624,7 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, nil),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.BankKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
@@ -608,7 +665,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -634,7 +691,7 @@ func NewExampleApp(
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
@@ -661,7 +718,7 @@ func NewExampleApp(
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	}
@@ -722,6 +779,11 @@ func NewExampleApp(
 		panic(fmt.Sprintf("failed to configure EVM mempool: %s", err.Error()))
 	}
 
+	// set the Merkle-Patricia mirror of the EVM state serving eth_getProof, if enabled
+	if err := app.configureStateMirror(appOpts, logger); err != nil {
+		panic(fmt.Sprintf("failed to configure EVM state mirror: %s", err.Error()))
+	}
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -963,6 +1025,9 @@ func (app *EVMD) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *EVMD) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	node.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	if app.EVMMempool != nil {
+		evmmempool.RegisterQueryService(app.GRPCQueryRouter(), app.EVMMempool)
+	}
 }
 
 // ---------------------------------------------
@@ -1064,6 +1129,11 @@ func (app *EVMD) GetMempool() sdkmempool.ExtMempool {
 	return app.EVMMempool
 }
 
+// GetStateMirror returns the Merkle-Patricia mirror of the EVM state, nil if it is disabled.
+func (app *EVMD) GetStateMirror() *statemirror.StateMirror {
+	return app.StateMirror
+}
+
 func (app *EVMD) GetAnteHandler() sdk.AnteHandler {
 	return app.BaseApp.AnteHandler()
 }
@@ -1084,6 +1154,10 @@ func (app *EVMD) Close() error {
 		app.Logger().Info("Shutting down mempool")
 		err = m.Close()
 	}
+	if app.StateMirror != nil {
+		app.Logger().Info("Shutting down state mirror")
+		err = errors.Join(err, app.StateMirror.Close())
+	}
 
 	msg := "Application gracefully shutdown"
 	err = errors.Join(err, app.BaseApp.Close())
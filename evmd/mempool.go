@@ -62,9 +62,13 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 // and overrides it with values from appOpts if they exist and are non-zero.
 func (app *EVMD) createMempoolConfig(appOpts servertypes.AppOptions, logger log.Logger) (*evmmempool.EVMMempoolConfig, error) {
 	return &evmmempool.EVMMempoolConfig{
-		AnteHandler:      app.GetAnteHandler(),
-		LegacyPoolConfig: evmconfig.GetLegacyPoolConfig(appOpts, logger),
-		BlockGasLimit:    evmconfig.GetBlockGasLimit(appOpts, logger),
-		MinTip:           evmconfig.GetMinTip(appOpts, logger),
+		AnteHandler:       app.GetAnteHandler(),
+		LegacyPoolConfig:  evmconfig.GetLegacyPoolConfig(appOpts, logger),
+		BlockGasLimit:     evmconfig.GetBlockGasLimit(appOpts, logger),
+		MinTip:            evmconfig.GetMinTip(appOpts, logger),
+		SelectionPolicy:   evmconfig.GetSelectionPolicy(appOpts, logger),
+		AccountKeeper:     app.AccountKeeper,
+		CheckStateContext: app.GetContextForCheckTx,
+		CosmosPriceBump:   evmconfig.GetCosmosPriceBump(appOpts, logger),
 	}, nil
 }
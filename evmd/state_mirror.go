@@ -0,0 +1,56 @@
+package evmd
+
+import (
+	"github.com/spf13/cast"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	srvflags "github.com/cosmos/evm/server/flags"
+	"github.com/cosmos/evm/statemirror"
+)
+
+// configureStateMirror sets up the Merkle-Patricia mirror of the EVM state when
+// it is enabled, it is notified of the state written by the vm keeper and
+// updated once the blocks are finalized.
+func (app *EVMD) configureStateMirror(appOpts servertypes.AppOptions, logger log.Logger) error {
+	if !cast.ToBool(appOpts.Get(srvflags.JSONRPCEnableStateMirror)) {
+		return nil
+	}
+
+	db, err := statemirror.OpenDB(cast.ToString(appOpts.Get(flags.FlagHome)))
+	if err != nil {
+		return err
+	}
+	interval := cast.ToUint64(appOpts.Get(srvflags.JSONRPCStateMirrorInterval))
+	keepRoots := cast.ToUint64(appOpts.Get(srvflags.JSONRPCStateMirrorKeepRoots))
+	stateMirror, err := statemirror.NewStateMirror(
+		db, interval, keepRoots, app.EVMKeeper, app.AccountKeeper, app.committedState, logger.With("module", "state-mirror"),
+	)
+	if err != nil {
+		return err
+	}
+
+	app.EVMKeeper.SetStateCommitListener(stateMirror)
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, stateMirror)
+	app.SetStreamingManager(streamingManager)
+
+	app.StateMirror = stateMirror
+	return nil
+}
+
+// committedState returns a context holding the committed state of a height, it
+// is read by the state mirror worker once the block is committed.
+func (app *EVMD) committedState(height int64) (sdk.Context, error) {
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cms, cmtproto.Header{Height: height}, false, app.Logger()), nil
+}
@@ -0,0 +1,12 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/ante"
+)
+
+func TestAnteHandlerWithFeeGranter(t *testing.T) {
+	ante.RunAnteHandlerWithFeeGranterTest(t, integration.CreateEvmd)
+}
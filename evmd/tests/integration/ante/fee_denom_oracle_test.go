@@ -0,0 +1,12 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/ante"
+)
+
+func TestFeeDenomOracle(t *testing.T) {
+	ante.RunFeeDenomOracleTest(t, integration.CreateEvmd)
+}
@@ -0,0 +1,12 @@
+package vesting
+
+import (
+	"testing"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/vesting"
+)
+
+func TestVestingPrecompileIntegrationTestSuite(t *testing.T) {
+	vesting.TestPrecompileIntegrationTestSuite(t, integration.CreateEvmd)
+}
@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -32,6 +34,7 @@ import (
 	"github.com/cosmos/evm/crypto/hd"
 	"github.com/cosmos/evm/evmd"
 	"github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
 	evmtestutil "github.com/cosmos/evm/testutil"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 
@@ -41,6 +44,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -65,6 +69,26 @@ var (
 	portPool = make(chan string, 200)
 )
 
+// init fills the pool with free ports, they are all reserved before being
+// released so that they are distinct.
+func init() {
+	listeners := make([]net.Listener, 0, cap(portPool))
+	for range cap(portPool) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			panic(err)
+		}
+		portPool <- strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+		listeners = append(listeners, l)
+	}
+
+	for _, l := range listeners {
+		if err := l.Close(); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // AppConstructor defines a function which accepts a network configuration and
 // creates an ABCI Application to provide to CometBFT.
 type AppConstructor = func(val Validator) servertypes.Application
@@ -97,6 +121,7 @@ type Config struct {
 	APIAddress               string // REST API listen address (including port)
 	GRPCAddress              string // GRPC server listen address (including port)
 	EnableCMTLogging         bool   // enable CometBFT logging to STDOUT
+	EnableTransferLogs       bool   // emit ERC-20 Transfer logs for the native token pairs moved outside of the EVM
 	CleanupDir               bool   // remove base temporary directory during cleanup
 	PrintMnemonic            bool   // print the mnemonic of first validator as log output for testing
 }
@@ -140,9 +165,13 @@ func DefaultConfig() Config {
 // NewAppConstructor returns a new Cosmos EVM AppConstructor
 func NewAppConstructor(chainID string) AppConstructor {
 	return func(val Validator) servertypes.Application {
+		appOpts := simutils.AppOptionsMap{
+			flags.FlagHome:                 val.Ctx.Config.RootDir,
+			srvflags.EVMEnableTransferLogs: val.AppConfig.EVM.EnableTransferLogs,
+		}
 		return evmd.NewExampleApp(
 			val.Ctx.Logger, dbm.NewMemDB(), nil, true,
-			simutils.NewAppOptionsWithFlagHome(val.Ctx.Config.RootDir),
+			appOpts,
 			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
 			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
 			baseapp.SetChainID(chainID),
@@ -263,6 +292,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		appCfg.API.Swagger = false
 		appCfg.Telemetry.Enabled = false
 		appCfg.Telemetry.GlobalLabels = [][]string{{"chain_id", cfg.ChainID}}
+		appCfg.EVM.EnableTransferLogs = cfg.EnableTransferLogs
 
 		ctx := server.NewDefaultContext()
 		cmtCfg := ctx.Config
@@ -4,15 +4,28 @@
 package network_test
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/stretchr/testify/suite"
 
 	cosmosevmnetwork "github.com/cosmos/evm/evmd/tests/network"
 	"github.com/cosmos/evm/server/config"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type IntegrationTestSuite struct {
@@ -28,6 +41,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := cosmosevmnetwork.DefaultConfig()
 	cfg.JSONRPCAddress = config.DefaultJSONRPCAddress
 	cfg.NumValidators = 1
+	cfg.EnableTransferLogs = true
 
 	s.network, err = cosmosevmnetwork.New(s.T(), s.T().TempDir(), cfg)
 	s.Require().NoError(err)
@@ -57,6 +71,50 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 	s.Require().GreaterOrEqual(latestHeight, h)
 }
 
+// TestTransferLogs checks that the bank sends of the native token pairs made by
+// Cosmos transactions are returned by eth_getLogs as ERC-20 Transfer logs.
+func (s *IntegrationTestSuite) TestTransferLogs() {
+	val := s.network.Validators[0]
+	recipient := utiltx.GenerateAddress()
+	amount := math.NewInt(1000)
+
+	startHeight, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	_, err = clitestutil.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		sdk.AccAddress(recipient.Bytes()),
+		sdk.NewCoins(sdk.NewCoin(s.network.Config.BondDenom, amount)),
+		val.ClientCtx.TxConfig.SigningContext().AddressCodec(),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.network.Config.BondDenom, math.NewInt(1e15)))),
+	)
+	s.Require().NoError(err)
+
+	query := ethereum.FilterQuery{
+		FromBlock: big.NewInt(startHeight),
+		Addresses: []common.Address{common.HexToAddress(testconstants.WEVMOSContractMainnet)},
+		Topics: [][]common.Hash{
+			{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))},
+			{common.BytesToHash(val.Address.Bytes())},
+			{common.BytesToHash(recipient.Bytes())},
+		},
+	}
+	s.Require().Eventually(func() bool {
+		logs, err := val.JSONRPCClient.FilterLogs(context.Background(), query)
+		s.Require().NoError(err)
+		if len(logs) == 0 {
+			return false
+		}
+		s.Require().Len(logs, 1)
+		s.Require().Equal(amount.BigInt(), new(big.Int).SetBytes(logs[0].Data))
+		s.Require().NotEqual(common.Hash{}, logs[0].TxHash)
+		return true
+	}, time.Minute, time.Second)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -20,6 +20,7 @@ import (
 	cmttime "github.com/cometbft/cometbft/types/time"
 
 	"github.com/cosmos/evm/server"
+	testconstants "github.com/cosmos/evm/testutil/constants"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -138,6 +139,7 @@ func startInProcess(cfg Config, val *Validator) error {
 			nil,
 			app.(server.AppWithPendingTxStream),
 			nil,
+			nil,
 		)
 		if err != nil {
 			return err
@@ -202,9 +204,24 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	authGenState.Accounts = append(authGenState.Accounts, accounts...)
 	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenState)
 
-	// set the balances in the genesis state
+	// set the balances and the metadata of the bond denom in the genesis state
 	var bankGenState banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+
 	bankGenState.Balances = genBalances
+	bankGenState.DenomMetadata = []banktypes.Metadata{
+		{
+			Description: "Native 18-decimal denom metadata for Cosmos EVM chain",
+			Base:        cfg.BondDenom,
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: cfg.BondDenom, Exponent: 0},
+				{Denom: testconstants.ExampleDisplayDenom, Exponent: evmtypes.EighteenDecimals.Uint32()},
+			},
+			Name:    "Cosmos EVM",
+			Symbol:  "ATOM",
+			Display: testconstants.ExampleDisplayDenom,
+		},
+	}
 	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
 
 	var stakingGenState stakingtypes.GenesisState
@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	KeyPrefixBlockRoots = 8
+
+	// BlockRootsLength is the length of the db entry of the block roots
+	BlockRootsLength = common.HashLength + common.HashLength + ethtypes.BloomByteLength
+)
+
+// GetBlockRoots returns the roots of the eth txs and receipts of a block,
+// returns nil if the block roots are not indexed.
+func (kv *KVIndexer) GetBlockRoots(height int64) (*servertypes.BlockRoots, error) {
+	bz, err := kv.db.Get(BlockRootsKey(height))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockRoots %d", height)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != BlockRootsLength {
+		return nil, fmt.Errorf("GetBlockRoots %d, invalid length: %d", height, len(bz))
+	}
+	return &servertypes.BlockRoots{
+		TxRoot:      common.BytesToHash(bz[:common.HashLength]),
+		ReceiptRoot: common.BytesToHash(bz[common.HashLength : 2*common.HashLength]),
+		Bloom:       ethtypes.BytesToBloom(bz[2*common.HashLength:]),
+	}, nil
+}
+
+// saveBlockRoots index the roots of the eth txs and receipts of a block into
+// the kv db batch
+func saveBlockRoots(batch dbm.Batch, height int64, roots *servertypes.BlockRoots) error {
+	value := make([]byte, 0, BlockRootsLength)
+	value = append(value, roots.TxRoot.Bytes()...)
+	value = append(value, roots.ReceiptRoot.Bytes()...)
+	value = append(value, roots.Bloom.Bytes()...)
+	return batch.Set(BlockRootsKey(height), value)
+}
+
+// BlockRootsKey returns the key for db entry: `block number -> tx root | receipt root | bloom`
+func BlockRootsKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockRoots}, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // height is not negative
+}
+
+// computeBlockRoots computes the roots of the eth txs and receipts of a block
+// from their indexed results, returns nil if the block has no eth txs. The
+// receipts have the same consensus fields as the ones returned by the
+// JSON-RPC, so that the roots can be verified against them.
+func computeBlockRoots(height int64, ethTxs []ethTxResult, txResults []*abci.ExecTxResult) (*servertypes.BlockRoots, error) {
+	if len(ethTxs) == 0 {
+		// the roots of the empty blocks are not worth storing
+		return nil, nil
+	}
+
+	txs := make(ethtypes.Transactions, len(ethTxs))
+	receipts := make(ethtypes.Receipts, len(ethTxs))
+	var cumulativeGasUsed uint64
+	for i, ethTx := range ethTxs {
+		logs, err := evmtypes.DecodeMsgLogs(
+			txResults[ethTx.result.TxIndex].Data,
+			int(ethTx.result.MsgIndex),
+			uint64(height), //nolint:gosec // G115 // height is not negative
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "decode logs of tx %s", ethTx.msg.Hash().Hex())
+		}
+
+		cumulativeGasUsed += ethTx.result.GasUsed
+		txs[i] = ethTx.msg.AsTransaction()
+		receipts[i] = rpctypes.ConsensusReceipt(txs[i], ethTx.result.Failed, cumulativeGasUsed, logs)
+	}
+
+	// same roots as ethtypes.NewBlock
+	return &servertypes.BlockRoots{
+		TxRoot:      ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptRoot: ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+		Bloom:       ethtypes.MergeBloom(receipts),
+	}, nil
+}
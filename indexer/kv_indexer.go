@@ -1,9 +1,12 @@
 package indexer
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +18,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,25 +27,62 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash    = 1
+	KeyPrefixTxIndex   = 2
+	KeyPrefixAddressTx = 3
+	// KeyPrefixIndexedRange is the key of the range of the processed blocks,
+	// including the ones without eth txs
+	KeyPrefixIndexedRange = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
 var _ servertypes.EVMTxIndexer = &KVIndexer{}
 
+// ErrAddressIndexDisabled is returned by the address lookups when the address
+// indexes are not enabled.
+var ErrAddressIndexDisabled = errors.New("address index disabled, enable it with json-rpc.enable-address-index")
+
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// addressIndex enables the indexes of the txs by address
+	addressIndex bool
+	// logIndex enables the index of the log addresses and topics
+	logIndex bool
+}
+
+// KVIndexerOption configures the KVIndexer.
+type KVIndexerOption func(kv *KVIndexer)
+
+// WithAddressIndex enables the indexes of the eth txs by the addresses of
+// their sender, recipient and created contract.
+func WithAddressIndex() KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.addressIndex = true
+	}
+}
+
+// WithoutLogIndex disables the index of the addresses and topics of the logs,
+// the log filters then scan every block of their range.
+func WithoutLogIndex() KVIndexerOption {
+	return func(kv *KVIndexer) {
+		kv.logIndex = false
+	}
 }
 
 // NewKVIndexer creates the KVIndexer
-func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context, opts ...KVIndexerOption) *KVIndexer {
+	kv := &KVIndexer{db: db, logger: logger, clientCtx: clientCtx, logIndex: true}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -49,88 +90,76 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the roots of the eth txs and receipts of the block
+// - Extends the range of the processed blocks with the block
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
-		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
+	ethTxs := parseEthTxs(kv.clientCtx, kv.logger, block, txResults)
+	for _, ethTx := range ethTxs {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethTx.msg.Hash(), &ethTx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := servertypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+		if kv.addressIndex {
+			if err := saveAddressTxs(batch, ethTx.msg, &ethTx.result); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
 	}
+	if kv.logIndex {
+		if err := kv.saveLogIndex(batch, height, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if roots, err := computeBlockRoots(height, ethTxs, txResults); err != nil {
+		kv.logger.Error("Fail to compute block roots", "err", err, "block", height)
+	} else if roots != nil {
+		if err := saveBlockRoots(batch, height, roots); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if err := saveIndexedRange(kv.db, batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
 	return nil
 }
 
-// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+// LastIndexedBlock returns the latest processed block number, including the
+// blocks without eth txs, returns -1 if db is empty
 func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
-	return LoadLastBlock(kv.db)
+	_, last, err := loadIndexedRange(kv.db)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	// the blocks indexed before the range was stored only have their eth txs
+	lastTx, err := LoadLastBlock(kv.db)
+	if err != nil {
+		return 0, err
+	}
+	return max(last, lastTx), nil
 }
 
-// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+// FirstIndexedBlock returns the first processed block number, including the
+// blocks without eth txs, returns -1 if db is empty
 func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
-	return LoadFirstBlock(kv.db)
+	first, _, err := loadIndexedRange(kv.db)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	firstTx, err := LoadFirstBlock(kv.db)
+	if err != nil {
+		return 0, err
+	}
+	if first < 0 || (firstTx >= 0 && firstTx < first) {
+		return firstTx, nil
+	}
+	return first, nil
 }
 
 // GetByTxHash finds eth tx by eth tx hash
@@ -161,6 +190,67 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*serv
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetByAddress returns a page of the eth txs involving the address in the
+// block range of the query. It fails if the address indexes are not enabled.
+func (kv *KVIndexer) GetByAddress(query servertypes.AddressTxQuery) (*servertypes.AddressTxPage, error) {
+	if !kv.addressIndex {
+		return nil, ErrAddressIndexDisabled
+	}
+
+	prefix := AddressTxPrefix(query.Address)
+	start := AddressTxKey(query.Address, max(query.FromBlock, 0), 0)
+	end := storetypes.PrefixEndBytes(prefix)
+	if query.ToBlock >= 0 {
+		end = AddressTxKey(query.Address, query.ToBlock+1, 0)
+	}
+	if query.Cursor != nil {
+		cursor := AddressTxKey(query.Address, query.Cursor.BlockNumber, query.Cursor.TxIndex)
+		if query.Descending {
+			// the cursor is included in the page, the keys have a fixed length
+			// so the next key is the cursor followed by a zero byte
+			if cursor = append(cursor, 0); bytes.Compare(cursor, end) < 0 {
+				end = cursor
+			}
+		} else if bytes.Compare(cursor, start) > 0 {
+			start = cursor
+		}
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return &servertypes.AddressTxPage{}, nil
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if query.Descending {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", query.Address.Hex())
+	}
+	defer it.Close()
+
+	page := &servertypes.AddressTxPage{}
+	for ; it.Valid(); it.Next() {
+		tx, err := parseAddressTx(it.Key(), it.Value())
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetByAddress %s", query.Address.Hex())
+		}
+		if query.Roles != 0 && tx.Roles&query.Roles == 0 {
+			continue
+		}
+		if query.Limit > 0 && len(page.Txs) >= query.Limit {
+			page.Next = &servertypes.AddressTxCursor{BlockNumber: tx.BlockNumber, TxIndex: tx.TxIndex}
+			break
+		}
+		page.Txs = append(page.Txs, tx)
+	}
+	return page, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +263,18 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressTxPrefix returns the prefix of the db entries of the txs involving an address
+func AddressTxPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> (tx hash, roles)`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(AddressTxPrefix(address), bz1...), bz2...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -199,6 +301,41 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// saveIndexedRange extends the range of the processed blocks with the block.
+func saveIndexedRange(db dbm.DB, batch dbm.Batch, height int64) error {
+	first, last, err := loadIndexedRange(db)
+	if err != nil {
+		return err
+	}
+	if first < 0 || height < first {
+		first = height
+	}
+	last = max(last, height)
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...) //nolint:gosec // G115 // heights are not negative
+	if err := batch.Set([]byte{KeyPrefixIndexedRange}, bz); err != nil {
+		return errorsmod.Wrap(err, "set indexed range")
+	}
+	return nil
+}
+
+// loadIndexedRange returns the first and last processed blocks, returns -1 if
+// no block is processed.
+func loadIndexedRange(db dbm.DB) (int64, int64, error) {
+	bz, err := db.Get([]byte{KeyPrefixIndexedRange})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong indexed range length, expect: 16, got: %d", len(bz))
+	}
+	first := int64(sdk.BigEndianToUint64(bz[:8])) //#nosec G115 -- int overflow is not a concern here
+	last := int64(sdk.BigEndianToUint64(bz[8:]))  //#nosec G115 -- int overflow is not a concern here
+	return first, last, nil
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -212,6 +349,81 @@ func isEthTx(tx sdk.Tx) bool {
 	return true
 }
 
+// ethTxResult is an eth tx of a block and its indexed result
+type ethTxResult struct {
+	msg    *evmtypes.MsgEthereumTx
+	result servertypes.TxResult
+}
+
+// parseEthTxs returns the eth txs of a block with their results, in the order
+// of their eth tx index. The txs that fail to be decoded or parsed are logged
+// and skipped.
+func parseEthTxs(clientCtx client.Context, logger log.Logger, block *cmttypes.Block, txResults []*abci.ExecTxResult) []ethTxResult {
+	height := block.Height
+
+	var ethTxs []ethTxResult
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+
+			txResult := servertypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethTxs = append(ethTxs, ethTxResult{msg: ethMsg, result: txResult})
+		}
+	}
+	return ethTxs
+}
+
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *servertypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -224,6 +436,31 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressTxs indexes the eth tx by the addresses of its sender, its
+// recipient and the contract it created. An address with several roles in the
+// tx has a single entry.
+func saveAddressTxs(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	tx := msg.AsTransaction()
+	from := msg.GetSender()
+
+	roles := map[common.Address]servertypes.AddressTxRole{from: servertypes.AddressTxRoleFrom}
+	switch {
+	case tx.To() != nil:
+		roles[*tx.To()] |= servertypes.AddressTxRoleTo
+	case !txResult.Failed:
+		roles[crypto.CreateAddress(from, tx.Nonce())] |= servertypes.AddressTxRoleCreated
+	}
+
+	txHash := msg.Hash()
+	for address, role := range roles {
+		value := append(txHash.Bytes(), byte(role))
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), value); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -231,3 +468,20 @@ func parseBlockNumberFromKey(key []byte) (int64, error) {
 
 	return int64(sdk.BigEndianToUint64(key[1:9])), nil //#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
 }
+
+func parseAddressTx(key, value []byte) (servertypes.AddressTx, error) {
+	if len(key) != AddressTxKeyLength {
+		return servertypes.AddressTx{}, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+	}
+	if len(value) != common.HashLength+1 {
+		return servertypes.AddressTx{}, fmt.Errorf("wrong address tx value length, expect: %d, got: %d", common.HashLength+1, len(value))
+	}
+
+	bz := key[1+common.AddressLength:]
+	return servertypes.AddressTx{
+		Hash:        common.BytesToHash(value[:common.HashLength]),
+		BlockNumber: int64(sdk.BigEndianToUint64(bz[:8])), //#nosec G115 -- int overflow is not a concern here
+		TxIndex:     int32(sdk.BigEndianToUint64(bz[8:])), //#nosec G115 -- the index is stored from an int32
+		Roles:       servertypes.AddressTxRole(value[common.HashLength]),
+	}, nil
+}
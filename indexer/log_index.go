@@ -0,0 +1,269 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	KeyPrefixLogBlock      = 4
+	KeyPrefixLogAddress    = 5
+	KeyPrefixLogTopic      = 6
+	KeyPrefixLogIndexRange = 7
+
+	// MaxLogTopics is the maximum number of topics of a log, only the topics at
+	// these positions are indexed.
+	MaxLogTopics = 4
+)
+
+// The log index maps the addresses and topics of the logs to the heights of
+// the blocks that contain them, so that the log filters over large block
+// ranges only fetch the results of the candidate blocks. A block may match
+// the address and topics of the filter with different logs, the candidate
+// blocks are a superset of the blocks with matching logs.
+//
+// The index keys are:
+// - `KeyPrefixLogBlock | height` for the blocks with logs
+// - `KeyPrefixLogAddress | address | height` for the log addresses
+// - `KeyPrefixLogTopic | position | topic | height` for the log topics
+// - `KeyPrefixLogIndexRange -> first height | last height` for the indexed range
+
+// GetLogBlocks returns the heights of the blocks in the inclusive range that
+// may contain logs matching the addresses and positional topics, using the
+// same semantics as the eth log filters. If limit is positive, at most limit+1
+// heights are returned, so that the callers can reject the queries matching
+// more than limit blocks without iterating the whole range. The boolean
+// result is false if the log index is disabled or the range is not covered
+// by it.
+func (kv *KVIndexer) GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	if !kv.logIndex {
+		return nil, false, nil
+	}
+	first, last, err := loadLogIndexRange(kv.db)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	if first < 0 || fromBlock < first || toBlock > last {
+		return nil, false, nil
+	}
+
+	// the heights are the union of the prefixes of a group and the
+	// intersection of the groups
+	var groups [][][]byte
+	if len(addresses) > 0 {
+		group := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			group[i] = LogAddressPrefix(address)
+		}
+		groups = append(groups, group)
+	}
+	for i, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		if i >= MaxLogTopics {
+			// no log has a topic at this position
+			return []int64{}, true, nil
+		}
+		group := make([][]byte, len(topicList))
+		for j, topic := range topicList {
+			group[j] = LogTopicPrefix(i, topic)
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) == 0 {
+		groups = append(groups, [][]byte{{KeyPrefixLogBlock}})
+	}
+
+	iters := make([]*logHeightIterator, 0, len(groups))
+	defer func() {
+		for _, iter := range iters {
+			iter.close()
+		}
+	}()
+	for _, group := range groups {
+		iter, err := kv.newLogHeightIterator(group, fromBlock, toBlock)
+		if err != nil {
+			return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+		}
+		iters = append(iters, iter)
+	}
+
+	// advance the groups in turn to the highest of their current heights
+	// until they all agree on a height
+	heights := []int64{}
+	target := fromBlock
+	for limit <= 0 || len(heights) <= limit {
+		height, agreed := int64(0), true
+		for i, iter := range iters {
+			h, ok := iter.seek(target)
+			if !ok {
+				return heights, true, nil
+			}
+			if i > 0 && h != height {
+				agreed = false
+			}
+			height = max(height, h)
+		}
+		if agreed {
+			heights = append(heights, height)
+			height++
+		}
+		target = height
+	}
+	return heights, true, nil
+}
+
+// logHeightIterator iterates in ascending order the heights of the log index
+// entries under any of the prefixes of a group.
+type logHeightIterator struct {
+	its []dbm.Iterator
+}
+
+// newLogHeightIterator opens the iterators of the prefixes of a group over the
+// inclusive range.
+func (kv *KVIndexer) newLogHeightIterator(prefixes [][]byte, fromBlock, toBlock int64) (*logHeightIterator, error) {
+	iter := &logHeightIterator{its: make([]dbm.Iterator, 0, len(prefixes))}
+	for _, prefix := range prefixes {
+		it, err := kv.db.Iterator(LogIndexKey(prefix, fromBlock), LogIndexKey(prefix, toBlock+1))
+		if err != nil {
+			iter.close()
+			return nil, err
+		}
+		iter.its = append(iter.its, it)
+	}
+	return iter, nil
+}
+
+// seek advances the iterator to the lowest height not lower than the target,
+// the boolean result is false if there is no such height.
+func (iter *logHeightIterator) seek(target int64) (int64, bool) {
+	var (
+		height int64
+		found  bool
+	)
+	for _, it := range iter.its {
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			h := int64(sdk.BigEndianToUint64(key[len(key)-8:])) //#nosec G115 -- int overflow is not a concern here
+			if h < target {
+				continue
+			}
+			if !found || h < height {
+				height, found = h, true
+			}
+			break
+		}
+	}
+	return height, found
+}
+
+func (iter *logHeightIterator) close() {
+	for _, it := range iter.its {
+		_ = it.Close()
+	}
+}
+
+// saveLogIndex indexes the addresses and topics of the logs of a block and
+// extends the indexed range with the block.
+func (kv *KVIndexer) saveLogIndex(batch dbm.Batch, height int64, txResults []*abci.ExecTxResult) error {
+	var (
+		hasLogs bool
+		// the keys are only written once per block
+		keys = make(map[string]struct{})
+	)
+	for txIndex, result := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //nolint:gosec // G115 // height is not negative
+		if err != nil {
+			kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		systemLogs, err := evmtypes.DecodeSystemLogs(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to decode system logs", "err", err, "block", height, "txIndex", txIndex)
+		}
+		logs = append(logs, systemLogs...)
+		for _, log := range logs {
+			hasLogs = true
+			keys[string(LogIndexKey(LogAddressPrefix(log.Address), height))] = struct{}{}
+			for i, topic := range log.Topics {
+				if i >= MaxLogTopics {
+					break
+				}
+				keys[string(LogIndexKey(LogTopicPrefix(i, topic), height))] = struct{}{}
+			}
+		}
+	}
+	if hasLogs {
+		keys[string(LogIndexKey([]byte{KeyPrefixLogBlock}, height))] = struct{}{}
+	}
+	for key := range keys {
+		if err := batch.Set([]byte(key), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log index key")
+		}
+	}
+
+	first, last, err := loadLogIndexRange(kv.db)
+	if err != nil {
+		return err
+	}
+	switch {
+	case first < 0 || height < first-1 || height > last+1:
+		// the range must be contiguous, a gap restarts it at the block
+		first, last = height, height
+	case height == first-1:
+		first = height
+	case height == last+1:
+		last = height
+	}
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...) //nolint:gosec // G115 // heights are not negative
+	if err := batch.Set([]byte{KeyPrefixLogIndexRange}, bz); err != nil {
+		return errorsmod.Wrap(err, "set log index range")
+	}
+	return nil
+}
+
+// LogAddressPrefix returns the prefix of the log index entries of an address
+func LogAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// LogTopicPrefix returns the prefix of the log index entries of a topic at the given position
+func LogTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
+// LogIndexKey returns the key for db entry: `prefix | height -> nil`
+func LogIndexKey(prefix []byte, height int64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	key = append(key, prefix...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // height is not negative
+}
+
+// loadLogIndexRange returns the first and last blocks covered by the log
+// index, returns -1 if no block is indexed.
+func loadLogIndexRange(db dbm.DB) (int64, int64, error) {
+	bz, err := db.Get([]byte{KeyPrefixLogIndexRange})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 16, got: %d", len(bz))
+	}
+	first := int64(sdk.BigEndianToUint64(bz[:8])) //#nosec G115 -- int overflow is not a concern here
+	last := int64(sdk.BigEndianToUint64(bz[8:]))  //#nosec G115 -- int overflow is not a concern here
+	return first, last, nil
+}
@@ -0,0 +1,126 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetLogBlocks(t *testing.T) {
+	var (
+		token  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		other  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		topicA = common.HexToHash("0xa")
+		topicB = common.HexToHash("0xb")
+		topicC = common.HexToHash("0xc")
+	)
+
+	// logs of the blocks 1 to 4, block 3 has no logs
+	blockLogs := map[int64][]*ethtypes.Log{
+		1: {{Address: token, Topics: []common.Hash{topicA, topicB}}},
+		2: {{Address: other, Topics: []common.Hash{topicB}}},
+		4: {
+			{Address: token, Topics: []common.Hash{topicC}},
+			{Address: other, Topics: []common.Hash{topicA, topicC}},
+		},
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	_, indexed, err := idxer.GetLogBlocks(1, 4, nil, nil, 0)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	for height := int64(1); height <= 4; height++ {
+		var txResults []*abci.ExecTxResult
+		if logs, ok := blockLogs[height]; ok {
+			res := &evmtypes.MsgEthereumTxResponse{Logs: evmtypes.NewLogsFromEth(logs)}
+			bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+			require.NoError(t, err)
+			txResults = append(txResults, &abci.ExecTxResult{Data: bz})
+		}
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, txResults))
+	}
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expBlocks []int64
+		expIndex  bool
+		limit     int
+	}{
+		{"range not indexed", 1, 5, nil, nil, nil, false, 0},
+		{"blocks with logs", 1, 4, nil, nil, []int64{1, 2, 4}, true, 0},
+		{"sub range", 2, 3, nil, nil, []int64{2}, true, 0},
+		{"address", 1, 4, []common.Address{token}, nil, []int64{1, 4}, true, 0},
+		{"addresses", 1, 4, []common.Address{token, other}, nil, []int64{1, 2, 4}, true, 0},
+		{"first topic", 1, 4, nil, [][]common.Hash{{topicA}}, []int64{1, 4}, true, 0},
+		{"second topic", 1, 4, nil, [][]common.Hash{nil, {topicB, topicC}}, []int64{1, 4}, true, 0},
+		{"address and topic", 1, 4, []common.Address{other}, [][]common.Hash{{topicB}}, []int64{2}, true, 0},
+		{"no match", 1, 4, []common.Address{token}, [][]common.Hash{{topicB}}, []int64{}, true, 0},
+		{"topic out of range", 1, 4, nil, [][]common.Hash{nil, nil, nil, nil, {topicA}}, []int64{}, true, 0},
+		{"limit", 1, 4, nil, nil, []int64{1, 2}, true, 1},
+		{"limit of address and topic", 1, 4, []common.Address{token, other}, [][]common.Hash{{topicA, topicB}}, []int64{1, 2}, true, 1},
+		{"limit not reached", 1, 4, []common.Address{token, other}, [][]common.Hash{{topicA, topicB}}, []int64{1, 2, 4}, true, 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, indexed, err := idxer.GetLogBlocks(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expIndex, indexed)
+			if tc.expIndex {
+				require.Equal(t, tc.expBlocks, blocks)
+			}
+		})
+	}
+
+	// a block out of the indexed range restarts the range
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 10}}, nil))
+	_, indexed, err = idxer.GetLogBlocks(1, 4, nil, nil, 0)
+	require.NoError(t, err)
+	require.False(t, indexed)
+	blocks, indexed, err := idxer.GetLogBlocks(10, 10, nil, nil, 0)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	require.Empty(t, blocks)
+}
+
+func TestGetLogBlocksWithoutLogIndex(t *testing.T) {
+	logs := []*ethtypes.Log{{Address: common.HexToAddress("0x1"), Topics: []common.Hash{common.HexToHash("0xa")}}}
+	res := &evmtypes.MsgEthereumTxResponse{Logs: evmtypes.NewLogsFromEth(logs)}
+	bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithoutLogIndex())
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, []*abci.ExecTxResult{{Data: bz}}))
+
+	_, indexed, err := idxer.GetLogBlocks(1, 1, nil, nil, 0)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	// no entry of the log index is written
+	it, err := db.Iterator([]byte{indexer.KeyPrefixLogBlock}, []byte{indexer.KeyPrefixLogIndexRange + 1})
+	require.NoError(t, err)
+	defer it.Close()
+	require.False(t, it.Valid())
+}
@@ -0,0 +1,517 @@
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	// SQLDriverSQLite is the database/sql driver name of SQLite
+	SQLDriverSQLite = "sqlite"
+	// SQLDriverPostgres is the database/sql driver name of PostgreSQL
+	SQLDriverPostgres = "postgres"
+)
+
+var _ servertypes.EVMTxIndexer = &SQLIndexer{}
+
+// sqlSchema creates the tables of the SQL indexer, the statements are valid
+// for both SQLite and PostgreSQL. The hashes and addresses are stored as
+// lowercase 0x-prefixed hex strings and the big integers as decimal strings.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS evm_blocks (
+		height     BIGINT PRIMARY KEY,
+		hash       TEXT NOT NULL,
+		block_time BIGINT NOT NULL,
+		tx_count   INTEGER NOT NULL,
+		gas_used   BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS evm_transactions (
+		hash         TEXT PRIMARY KEY,
+		height       BIGINT NOT NULL,
+		tx_index     INTEGER NOT NULL,
+		msg_index    INTEGER NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		tx_type      SMALLINT NOT NULL,
+		from_address TEXT NOT NULL,
+		to_address   TEXT,
+		nonce        BIGINT NOT NULL,
+		value        TEXT NOT NULL,
+		gas_limit    BIGINT NOT NULL,
+		gas_price    TEXT NOT NULL,
+		input        TEXT NOT NULL
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS evm_transactions_height_idx ON evm_transactions (height, eth_tx_index)`,
+	`CREATE INDEX IF NOT EXISTS evm_transactions_from_idx ON evm_transactions (from_address, height, eth_tx_index)`,
+	`CREATE INDEX IF NOT EXISTS evm_transactions_to_idx ON evm_transactions (to_address, height, eth_tx_index)`,
+	`CREATE TABLE IF NOT EXISTS evm_receipts (
+		tx_hash             TEXT PRIMARY KEY,
+		height              BIGINT NOT NULL,
+		eth_tx_index        INTEGER NOT NULL,
+		failed              BOOLEAN NOT NULL,
+		gas_used            BIGINT NOT NULL,
+		cumulative_gas_used BIGINT NOT NULL,
+		contract_address    TEXT
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_receipts_height_idx ON evm_receipts (height)`,
+	`CREATE INDEX IF NOT EXISTS evm_receipts_contract_idx ON evm_receipts (contract_address)`,
+	`CREATE TABLE IF NOT EXISTS evm_logs (
+		height       BIGINT NOT NULL,
+		log_index    INTEGER NOT NULL,
+		tx_hash      TEXT NOT NULL,
+		eth_tx_index INTEGER NOT NULL,
+		address      TEXT NOT NULL,
+		topic0       TEXT,
+		topic1       TEXT,
+		topic2       TEXT,
+		topic3       TEXT,
+		data         TEXT NOT NULL,
+		PRIMARY KEY (height, log_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_address_idx ON evm_logs (address, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_topic0_idx ON evm_logs (topic0, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_topic1_idx ON evm_logs (topic1, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_topic2_idx ON evm_logs (topic2, height)`,
+	`CREATE INDEX IF NOT EXISTS evm_logs_topic3_idx ON evm_logs (topic3, height)`,
+	`CREATE TABLE IF NOT EXISTS evm_block_roots (
+		height            BIGINT PRIMARY KEY,
+		transactions_root TEXT NOT NULL,
+		receipts_root     TEXT NOT NULL,
+		logs_bloom        TEXT NOT NULL
+	)`,
+}
+
+// SQLIndexer implements a eth tx indexer on a SQL database, it stores the
+// blocks, txs, receipts and logs in relational tables.
+type SQLIndexer struct {
+	db        *sql.DB
+	driver    string
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer creates the SQLIndexer and the tables of its schema, the
+// driver is the name of the database/sql driver of the db.
+func NewSQLIndexer(db *sql.DB, driver string, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if driver != SQLDriverSQLite && driver != SQLDriverPostgres {
+		return nil, fmt.Errorf("unsupported sql indexer driver, expect: %s|%s, got: %s", SQLDriverSQLite, SQLDriverPostgres, driver)
+	}
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errorsmod.Wrap(err, "create sql indexer schema")
+		}
+	}
+	return &SQLIndexer{db: db, driver: driver, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// IndexBlock stores the block, its eth txs, their receipts and logs, and the
+// roots of the txs and receipts in a single db transaction. Indexing a block
+// again replaces its rows.
+func (s *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+
+	dbTx, err := s.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck // no-op once committed
+
+	for _, table := range []string{"evm_logs", "evm_receipts", "evm_transactions", "evm_blocks", "evm_block_roots"} {
+		if _, err := dbTx.Exec(s.rebind("DELETE FROM "+table+" WHERE height = ?"), height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, delete %s", height, table)
+		}
+	}
+
+	var gasUsed uint64
+	ethTxs := parseEthTxs(s.clientCtx, s.logger, block, txResults)
+	for _, ethTx := range ethTxs {
+		if err := s.insertTx(dbTx, ethTx.msg, &ethTx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		gasUsed += ethTx.result.GasUsed
+	}
+
+	for txIndex, result := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //nolint:gosec // G115 // height is not negative
+		if err != nil {
+			s.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		systemLogs, err := evmtypes.DecodeSystemLogs(result.Events)
+		if err != nil {
+			s.logger.Error("Fail to decode system logs", "err", err, "block", height, "txIndex", txIndex)
+		}
+		for _, log := range systemLogs {
+			log.TxIndex = uint(txIndex) //nolint:gosec // G115 // won't exceed uint
+		}
+		for _, log := range append(logs, systemLogs...) {
+			topics := make([]any, MaxLogTopics)
+			for i, topic := range log.Topics {
+				if i >= MaxLogTopics {
+					break
+				}
+				topics[i] = topic.Hex()
+			}
+			if _, err := dbTx.Exec(
+				s.rebind(`INSERT INTO evm_logs (height, log_index, tx_hash, eth_tx_index, address, topic0, topic1, topic2, topic3, data)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+				height, log.Index, log.TxHash.Hex(), log.TxIndex, sqlAddress(log.Address),
+				topics[0], topics[1], topics[2], topics[3], hexutil.Encode(log.Data),
+			); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d, insert log", height)
+			}
+		}
+	}
+
+	if _, err := dbTx.Exec(
+		s.rebind("INSERT INTO evm_blocks (height, hash, block_time, tx_count, gas_used) VALUES (?, ?, ?, ?, ?)"),
+		height, common.BytesToHash(block.Hash()).Hex(), block.Time.Unix(), len(ethTxs), int64(gasUsed), //nolint:gosec // G115 // gas used won't exceed int64
+	); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, insert block", height)
+	}
+	roots, err := computeBlockRoots(height, ethTxs, txResults)
+	if err != nil {
+		s.logger.Error("Fail to compute block roots", "err", err, "block", height)
+	}
+	if roots != nil {
+		if _, err := dbTx.Exec(
+			s.rebind("INSERT INTO evm_block_roots (height, transactions_root, receipts_root, logs_bloom) VALUES (?, ?, ?, ?)"),
+			height, roots.TxRoot.Hex(), roots.ReceiptRoot.Hex(), hexutil.Encode(roots.Bloom.Bytes()),
+		); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, insert block roots", height)
+		}
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit", height)
+	}
+	return nil
+}
+
+// insertTx inserts the eth tx and its receipt
+func (s *SQLIndexer) insertTx(dbTx *sql.Tx, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	tx := msg.AsTransaction()
+	from := msg.GetSender()
+	txHash := msg.Hash().Hex()
+
+	var to, contract any
+	switch {
+	case tx.To() != nil:
+		to = sqlAddress(*tx.To())
+	case !txResult.Failed:
+		contract = sqlAddress(crypto.CreateAddress(from, tx.Nonce()))
+	}
+
+	if _, err := dbTx.Exec(
+		s.rebind(`INSERT INTO evm_transactions (hash, height, tx_index, msg_index, eth_tx_index, tx_type, from_address, to_address, nonce, value, gas_limit, gas_price, input)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		txHash, txResult.Height, txResult.TxIndex, txResult.MsgIndex, txResult.EthTxIndex, tx.Type(),
+		sqlAddress(from), to, int64(tx.Nonce()), tx.Value().String(), int64(tx.Gas()), //nolint:gosec // G115 // nonce and gas won't exceed int64
+		tx.GasPrice().String(), hexutil.Encode(tx.Data()),
+	); err != nil {
+		return errorsmod.Wrap(err, "insert tx")
+	}
+	if _, err := dbTx.Exec(
+		s.rebind(`INSERT INTO evm_receipts (tx_hash, height, eth_tx_index, failed, gas_used, cumulative_gas_used, contract_address)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		txHash, txResult.Height, txResult.EthTxIndex, txResult.Failed,
+		int64(txResult.GasUsed), int64(txResult.CumulativeGasUsed), contract, //nolint:gosec // G115 // gas used won't exceed int64
+	); err != nil {
+		return errorsmod.Wrap(err, "insert receipt")
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) LastIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow("SELECT MAX(height) FROM evm_blocks").Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow("SELECT MIN(height) FROM evm_blocks").Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+const sqlSelectTxResult = `SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.failed, r.gas_used, r.cumulative_gas_used
+	FROM evm_transactions t JOIN evm_receipts r ON r.tx_hash = t.hash`
+
+// GetByTxHash finds eth tx by eth tx hash
+func (s *SQLIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
+	txResult, err := s.queryTxResult(sqlSelectTxResult+" WHERE t.hash = ?", hash.Hex())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (s *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*servertypes.TxResult, error) {
+	txResult, err := s.queryTxResult(sqlSelectTxResult+" WHERE t.height = ? AND t.eth_tx_index = ?", blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return txResult, nil
+}
+
+// GetBlockRoots returns the roots of the eth txs and receipts of a block,
+// returns nil if the block roots are not indexed.
+func (s *SQLIndexer) GetBlockRoots(height int64) (*servertypes.BlockRoots, error) {
+	var txRoot, receiptRoot, bloom string
+	err := s.db.QueryRow(
+		s.rebind("SELECT transactions_root, receipts_root, logs_bloom FROM evm_block_roots WHERE height = ?"), height,
+	).Scan(&txRoot, &receiptRoot, &bloom)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockRoots %d", height)
+	}
+	bloomBz, err := hexutil.Decode(bloom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockRoots %d", height)
+	}
+	return &servertypes.BlockRoots{
+		TxRoot:      common.HexToHash(txRoot),
+		ReceiptRoot: common.HexToHash(receiptRoot),
+		Bloom:       ethtypes.BytesToBloom(bloomBz),
+	}, nil
+}
+
+func (s *SQLIndexer) queryTxResult(query string, args ...any) (*servertypes.TxResult, error) {
+	var (
+		txResult                   servertypes.TxResult
+		gasUsed, cumulativeGasUsed int64
+	)
+	if err := s.db.QueryRow(s.rebind(query), args...).Scan(
+		&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex,
+		&txResult.Failed, &gasUsed, &cumulativeGasUsed,
+	); err != nil {
+		return nil, err
+	}
+	txResult.GasUsed = uint64(gasUsed)                     //nolint:gosec // G115 // stored from an uint64
+	txResult.CumulativeGasUsed = uint64(cumulativeGasUsed) //nolint:gosec // G115 // stored from an uint64
+	return &txResult, nil
+}
+
+// GetByAddress returns a page of the eth txs involving the address in the
+// block range of the query.
+func (s *SQLIndexer) GetByAddress(query servertypes.AddressTxQuery) (*servertypes.AddressTxPage, error) {
+	address := sqlAddress(query.Address)
+	roles := query.Roles
+	if roles == 0 {
+		roles = servertypes.AddressTxRoleFrom | servertypes.AddressTxRoleTo | servertypes.AddressTxRoleCreated
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	if roles&servertypes.AddressTxRoleFrom != 0 {
+		conds = append(conds, "t.from_address = ?")
+		args = append(args, address)
+	}
+	if roles&servertypes.AddressTxRoleTo != 0 {
+		conds = append(conds, "t.to_address = ?")
+		args = append(args, address)
+	}
+	if roles&servertypes.AddressTxRoleCreated != 0 {
+		conds = append(conds, "r.contract_address = ?")
+		args = append(args, address)
+	}
+
+	var q strings.Builder
+	q.WriteString(`SELECT t.hash, t.height, t.eth_tx_index, t.from_address, t.to_address, r.contract_address
+	FROM evm_transactions t JOIN evm_receipts r ON r.tx_hash = t.hash
+	WHERE (` + strings.Join(conds, " OR ") + ") AND t.height >= ?")
+	args = append(args, max(query.FromBlock, 0))
+	if query.ToBlock >= 0 {
+		q.WriteString(" AND t.height <= ?")
+		args = append(args, query.ToBlock)
+	}
+	order := "ASC"
+	if query.Cursor != nil {
+		// the cursor is included in the page
+		cmp := ">"
+		if query.Descending {
+			cmp = "<"
+		}
+		q.WriteString(" AND (t.height " + cmp + " ? OR (t.height = ? AND t.eth_tx_index " + cmp + "= ?))")
+		args = append(args, query.Cursor.BlockNumber, query.Cursor.BlockNumber, query.Cursor.TxIndex)
+	}
+	if query.Descending {
+		order = "DESC"
+	}
+	q.WriteString(" ORDER BY t.height " + order + ", t.eth_tx_index " + order)
+	if query.Limit > 0 {
+		// the extra tx is the cursor of the next page
+		q.WriteString(" LIMIT " + strconv.Itoa(query.Limit+1))
+	}
+
+	rows, err := s.db.Query(s.rebind(q.String()), args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", query.Address.Hex())
+	}
+	defer rows.Close()
+
+	page := &servertypes.AddressTxPage{}
+	for rows.Next() {
+		var (
+			tx           servertypes.AddressTx
+			hash, from   string
+			to, contract sql.NullString
+		)
+		if err := rows.Scan(&hash, &tx.BlockNumber, &tx.TxIndex, &from, &to, &contract); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetByAddress %s", query.Address.Hex())
+		}
+		if query.Limit > 0 && len(page.Txs) >= query.Limit {
+			page.Next = &servertypes.AddressTxCursor{BlockNumber: tx.BlockNumber, TxIndex: tx.TxIndex}
+			break
+		}
+		tx.Hash = common.HexToHash(hash)
+		if from == address {
+			tx.Roles |= servertypes.AddressTxRoleFrom
+		}
+		if to.String == address {
+			tx.Roles |= servertypes.AddressTxRoleTo
+		}
+		if contract.String == address {
+			tx.Roles |= servertypes.AddressTxRoleCreated
+		}
+		page.Txs = append(page.Txs, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByAddress %s", query.Address.Hex())
+	}
+	return page, nil
+}
+
+// GetLogBlocks returns the heights of the blocks in the inclusive range that
+// contain logs matching the addresses and positional topics. If limit is
+// positive, at most limit+1 heights are returned. The boolean result is false
+// if some blocks of the range are not indexed.
+func (s *SQLIndexer) GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	var count int64
+	if err := s.db.QueryRow(
+		s.rebind("SELECT COUNT(*) FROM evm_blocks WHERE height >= ? AND height <= ?"), fromBlock, toBlock,
+	).Scan(&count); err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	if count != toBlock-fromBlock+1 {
+		return nil, false, nil
+	}
+
+	q := "SELECT DISTINCT height FROM evm_logs WHERE height >= ? AND height <= ?"
+	args := []any{fromBlock, toBlock}
+	if len(addresses) > 0 {
+		q += " AND address IN (" + sqlPlaceholders(len(addresses)) + ")"
+		for _, address := range addresses {
+			args = append(args, sqlAddress(address))
+		}
+	}
+	for i, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		if i >= MaxLogTopics {
+			// no log has a topic at this position
+			return []int64{}, true, nil
+		}
+		q += fmt.Sprintf(" AND topic%d IN (%s)", i, sqlPlaceholders(len(topicList)))
+		for _, topic := range topicList {
+			args = append(args, topic.Hex())
+		}
+	}
+	q += " ORDER BY height"
+	if limit > 0 {
+		q += " LIMIT ?"
+		args = append(args, limit+1)
+	}
+
+	rows, err := s.db.Query(s.rebind(q), args...)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	defer rows.Close()
+
+	heights := []int64{}
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	return heights, true, nil
+}
+
+// rebind replaces the `?` placeholders of the query with the positional
+// `$n` placeholders of PostgreSQL.
+func (s *SQLIndexer) rebind(query string) string {
+	if s.driver != SQLDriverPostgres {
+		return query
+	}
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// sqlPlaceholders returns n comma separated placeholders
+func sqlPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// sqlAddress returns the lowercase hex of the address, the addresses are
+// compared as strings by the queries.
+func sqlAddress(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
@@ -12,6 +12,8 @@ import (
 // NewCheckTxHandler creates a CheckTx handler that integrates with the EVM mempool for transaction validation.
 // It wraps the standard transaction execution flow to handle EVM-specific nonce gap errors by routing
 // transactions with higher tx sequence numbers to the mempool for potential future execution.
+// Cosmos transactions whose sequence is already taken are routed to the mempool as replacements of
+// the transaction holding the sequence.
 // Returns a handler function that processes ABCI CheckTx requests and manages EVM transaction sequencing.
 func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 	return func(runTx types.RunTx, request *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
@@ -19,12 +21,27 @@ func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 		if err != nil {
 			// detect if there is a nonce gap error (only returned for EVM transactions)
 			if errors.Is(err, ErrNonceGap) || errors.Is(err, ErrNonceLow) {
-				// send it to the mempool for further triage
+				// send it to the mempool for further triage, the pool applies its admission
+				// rules (nonce gap limit, queued funds) to the queued transactions
 				err := mempool.InsertInvalidNonce(request.Tx)
 				if err != nil {
 					return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
 				}
 			}
+			// detect if the sequence of a Cosmos transaction is already taken, the transaction
+			// may replace the one holding it in the mempool
+			if errors.Is(err, sdkerrors.ErrWrongSequence) {
+				replaceErr := mempool.ReplaceCosmosTx(request.Tx)
+				if replaceErr == nil {
+					return &abci.ResponseCheckTx{
+						GasWanted: int64(gInfo.GasWanted), // #nosec G115 -- this is copied from the Cosmos SDK
+						GasUsed:   int64(gInfo.GasUsed),   // #nosec G115 -- this is copied from the Cosmos SDK
+					}, nil
+				}
+				if !errors.Is(replaceErr, ErrNotReplaceable) {
+					return sdkerrors.ResponseCheckTxWithEvents(replaceErr, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
+				}
+			}
 			// anything else, return regular error
 			return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
 		}
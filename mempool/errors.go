@@ -7,6 +7,10 @@ var (
 	ErrExpectedOneMessage = errors.New("expected 1 message")
 	ErrExpectedOneError   = errors.New("expected 1 error")
 	ErrNotEVMTransaction  = errors.New("transaction is not an EVM transaction")
+	ErrFeeGrantedEVMTx    = errors.New("EVM transaction fees are paid by a fee granter")
 	ErrNonceGap           = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow           = errors.New("tx nonce is lower than account nonce")
+	ErrNotReplaceable     = errors.New("Cosmos transaction cannot replace a transaction of the mempool")
+	ErrReplaceUnderpriced = errors.New("replacement Cosmos transaction underpriced")
+	ErrCosmosTxNotFound   = errors.New("Cosmos transaction not found in the mempool")
 )
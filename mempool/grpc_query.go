@@ -0,0 +1,122 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/mempool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// queryServer implements the mempool inspection gRPC service.
+type queryServer struct {
+	mempool *ExperimentalEVMMempool
+}
+
+// NewQueryServer creates the mempool inspection gRPC service of the mempool.
+func NewQueryServer(mempool *ExperimentalEVMMempool) types.QueryServer {
+	return queryServer{mempool: mempool}
+}
+
+// RegisterQueryService registers the mempool inspection gRPC service on the
+// server, e.g. the gRPC query router of the app.
+func RegisterQueryService(server gogogrpc.Server, mempool *ExperimentalEVMMempool) {
+	types.RegisterQueryServer(server, NewQueryServer(mempool))
+}
+
+// Status implements the Query/Status gRPC method
+func (s queryServer) Status(context.Context, *types.QueryStatusRequest) (*types.QueryStatusResponse, error) {
+	pending, queued := s.mempool.GetTxPool().Stats()
+	return &types.QueryStatusResponse{
+		EvmPending: uint64(pending),                   // #nosec G115 -- tx counts are never negative
+		EvmQueued:  uint64(queued),                    // #nosec G115 -- tx counts are never negative
+		Cosmos:     uint64(s.mempool.CountCosmosTx()), // #nosec G115 -- tx counts are never negative
+	}, nil
+}
+
+// CosmosContent implements the Query/CosmosContent gRPC method
+func (s queryServer) CosmosContent(_ context.Context, req *types.QueryCosmosContentRequest) (*types.QueryCosmosContentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txs, pageRes, err := s.mempool.CosmosContentPage(nil, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryCosmosContentResponse{Txs: cosmosTxsToProto(txs), Pagination: pageRes}, nil
+}
+
+// CosmosContentFrom implements the Query/CosmosContentFrom gRPC method
+func (s queryServer) CosmosContentFrom(_ context.Context, req *types.QueryCosmosContentFromRequest) (*types.QueryCosmosContentFromResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	signer, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+
+	txs, pageRes, err := s.mempool.CosmosContentPage(signer, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryCosmosContentFromResponse{Txs: cosmosTxsToProto(txs), Pagination: pageRes}, nil
+}
+
+// NextBlock implements the Query/NextBlock gRPC method
+func (s queryServer) NextBlock(context.Context, *types.QueryNextBlockRequest) (*types.QueryNextBlockResponse, error) {
+	block, err := s.mempool.NextBlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryNextBlockResponse{
+		Txs:           make([]*types.SelectedTx, 0, len(block.Txs)),
+		Gas:           block.Gas,
+		BlockGasLimit: block.BlockGasLimit,
+	}
+	for _, tx := range block.Txs {
+		selected := &types.SelectedTx{
+			Gas:          tx.Gas,
+			EffectiveTip: tx.EffectiveTip.Dec(),
+		}
+		if tx.EVMTx != nil {
+			selected.Hash = tx.EVMTx.Hash().Hex()
+			selected.Evm = true
+			selected.Sender = tx.From.Hex()
+			selected.Nonce = tx.EVMTx.Nonce()
+		} else {
+			selected.Hash = fmt.Sprintf("%X", tx.CosmosTx.Hash)
+			selected.Sender = tx.CosmosTx.Signer.String()
+			selected.Nonce = tx.CosmosTx.Sequence
+		}
+		res.Txs = append(res.Txs, selected)
+	}
+	return res, nil
+}
+
+// cosmosTxsToProto converts the Cosmos transactions of the mempool to their
+// gRPC representation.
+func cosmosTxsToProto(txs []CosmosTxInfo) []*types.CosmosTx {
+	res := make([]*types.CosmosTx, 0, len(txs))
+	for _, tx := range txs {
+		res = append(res, &types.CosmosTx{
+			Hash:        fmt.Sprintf("%X", tx.Hash),
+			Signer:      tx.Signer.String(),
+			Sequence:    tx.Sequence,
+			Priority:    tx.Priority.String(),
+			GasWanted:   tx.GasWanted,
+			Fee:         tx.Fee.String(),
+			MsgTypeUrls: tx.MsgTypeURLs,
+		})
+	}
+	return res
+}
@@ -0,0 +1,314 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CosmosTxInfo describes a Cosmos transaction held in the mempool.
+type CosmosTxInfo struct {
+	// Hash is the CometBFT hash of the transaction
+	Hash []byte
+	// Signer is the signer the Cosmos pool orders the transaction by, i.e. its
+	// first signer
+	Signer   sdk.AccAddress
+	Sequence uint64
+	// Priority is the priority of the transaction in the Cosmos pool
+	Priority    math.Int
+	GasWanted   uint64
+	Fee         sdk.Coins
+	MsgTypeURLs []string
+}
+
+// SelectedTxInfo describes a transaction predicted to be selected in the next
+// block. Exactly one of EVMTx and CosmosTx is set.
+type SelectedTxInfo struct {
+	EVMTx *ethtypes.Transaction
+	// From is the sender of the EVM transaction
+	From     common.Address
+	CosmosTx *CosmosTxInfo
+	// Gas is the gas limit of the transaction
+	Gas uint64
+	// EffectiveTip is the effective tip per gas the selection policy compared
+	// the transaction by
+	EffectiveTip *uint256.Int
+}
+
+// String returns a short description of the Cosmos transaction.
+func (tx CosmosTxInfo) String() string {
+	return fmt.Sprintf("%s fee for %d gas, priority %s: %s", tx.Fee, tx.GasWanted, tx.Priority, strings.Join(tx.MsgTypeURLs, ", "))
+}
+
+// NextBlockInfo is the predicted selection of the transactions of the next
+// block.
+type NextBlockInfo struct {
+	Txs []SelectedTxInfo
+	// Gas is the total gas limit of the selected transactions
+	Gas           uint64
+	BlockGasLimit uint64
+}
+
+// CountCosmosTx returns the number of Cosmos transactions in the mempool.
+func (m *ExperimentalEVMMempool) CountCosmosTx() int {
+	return m.cosmosPool.CountTx()
+}
+
+// CosmosContent returns the Cosmos transactions of the mempool, in the order of
+// the Cosmos pool.
+func (m *ExperimentalEVMMempool) CosmosContent() ([]CosmosTxInfo, error) {
+	txs, _, err := m.cosmosContent(nil, nil)
+	return txs, err
+}
+
+// CosmosContentFrom returns the Cosmos transactions of the mempool signed by the
+// signer, ordered by sequence.
+func (m *ExperimentalEVMMempool) CosmosContentFrom(signer sdk.AccAddress) ([]CosmosTxInfo, error) {
+	txs, _, err := m.cosmosContent(signer, nil)
+	return txs, err
+}
+
+// CosmosContentPage returns a page of the Cosmos transactions of the mempool,
+// only the ones of the signer if it is not nil. Only the transactions of the
+// page are described.
+func (m *ExperimentalEVMMempool) CosmosContentPage(signer sdk.AccAddress, pageReq *query.PageRequest) ([]CosmosTxInfo, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	return m.cosmosContent(signer, pageReq)
+}
+
+// cosmosContent returns the Cosmos transactions of the mempool, only the ones
+// of the signer if it is not nil and only a page of them if the page request is
+// not nil. The pool is only locked to snapshot its transactions.
+func (m *ExperimentalEVMMempool) cosmosContent(signer sdk.AccAddress, pageReq *query.PageRequest) ([]CosmosTxInfo, *query.PageResponse, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest context: %w", err)
+	}
+
+	m.mtx.Lock()
+	txs := m.cosmosTxs(ctx)
+	m.mtx.Unlock()
+
+	if signer != nil {
+		signed := txs[:0]
+		for _, tx := range txs {
+			signers, err := m.signerExtractor.GetSigners(tx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get signers of Cosmos transaction: %w", err)
+			}
+			if len(signers) > 0 && signers[0].Signer.Equals(signer) {
+				signed = append(signed, tx)
+			}
+		}
+		txs = signed
+	}
+
+	var pageRes *query.PageResponse
+	if pageReq != nil {
+		if txs, pageRes, err = paginate(txs, pageReq); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	infos := make([]CosmosTxInfo, 0, len(txs))
+	for _, tx := range txs {
+		info, err := m.cosmosTxInfo(ctx, tx)
+		if err != nil {
+			return nil, nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, pageRes, nil
+}
+
+// cosmosTxs returns a snapshot of the transactions of the Cosmos pool, in the
+// order of the pool. The caller must hold the mempool lock.
+func (m *ExperimentalEVMMempool) cosmosTxs(ctx sdk.Context) []sdk.Tx {
+	txs := make([]sdk.Tx, 0, m.cosmosPool.CountTx())
+	m.cosmosPool.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		txs = append(txs, tx)
+		return true
+	})
+	return txs
+}
+
+// NextBlock predicts the transactions selected in the next block: the
+// transactions of the unified iterator, as many as fit in the block gas limit.
+// Like the block proposal, a transaction that doesn't fit is skipped and the
+// selection ends once the block is full. The transactions are not executed and
+// the block size limit is not accounted for, so the proposal may still differ.
+func (m *ExperimentalEVMMempool) NextBlock() (*NextBlockInfo, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest context: %w", err)
+	}
+
+	// the EVM pending transactions are already a snapshot, the Cosmos ones are
+	// copied so that the pool is not locked during the selection
+	m.mtx.Lock()
+	evmIterator := m.getEVMIterator(ctx)
+	cosmosIterator := newTxSliceIterator(m.cosmosTxs(ctx))
+	m.mtx.Unlock()
+
+	block := &NextBlockInfo{BlockGasLimit: m.blockGasLimit}
+
+	iterator, ok := NewEVMMempoolIterator(ctx, evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.feeDenomOracle, m.blockchain.Config().ChainID, m.blockchain, m.selectionPolicy.NewBlockSelector(m.blockGasLimit)).(*EVMMempoolIterator)
+	if !ok {
+		return block, nil
+	}
+
+	signer := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	for block.Gas < block.BlockGasLimit {
+		var selected SelectedTxInfo
+		if iterator.shouldUseEVM() {
+			lazyTx, tip := iterator.getNextEVMTx()
+			tx := lazyTx.Resolve()
+			if tx == nil {
+				// the transaction was removed from the pool in the meantime
+				if iterator.Next() == nil {
+					break
+				}
+				continue
+			}
+			from, err := ethtypes.Sender(signer, tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to recover sender of transaction %s: %w", tx.Hash(), err)
+			}
+			selected = SelectedTxInfo{EVMTx: tx, From: from, Gas: lazyTx.Gas, EffectiveTip: tip}
+		} else {
+			tx, tip := iterator.getNextCosmosTx()
+			info, err := m.cosmosTxInfo(ctx, tx)
+			if err != nil {
+				return nil, err
+			}
+			selected = SelectedTxInfo{CosmosTx: &info, Gas: info.GasWanted, EffectiveTip: tip}
+		}
+
+		if fitsIn(block.Gas, selected.Gas, block.BlockGasLimit) {
+			block.Txs = append(block.Txs, selected)
+			block.Gas += selected.Gas
+		}
+		if iterator.Next() == nil {
+			break
+		}
+	}
+	return block, nil
+}
+
+// cosmosTxInfo describes a Cosmos transaction of the Cosmos pool.
+func (m *ExperimentalEVMMempool) cosmosTxInfo(ctx sdk.Context, tx sdk.Tx) (CosmosTxInfo, error) {
+	txBytes, err := m.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return CosmosTxInfo{}, fmt.Errorf("failed to encode Cosmos transaction: %w", err)
+	}
+	info := CosmosTxInfo{
+		Hash:     cmttypes.Tx(txBytes).Hash(),
+		Priority: m.cosmosTxPriority.GetTxPriority(ctx, tx),
+	}
+
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return CosmosTxInfo{}, fmt.Errorf("failed to get signers of Cosmos transaction %X: %w", info.Hash, err)
+	}
+	if len(signers) > 0 {
+		info.Signer = signers[0].Signer
+		info.Sequence = signers[0].Sequence
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		info.GasWanted = feeTx.GetGas()
+		info.Fee = feeTx.GetFee()
+	}
+	for _, msg := range tx.GetMsgs() {
+		info.MsgTypeURLs = append(info.MsgTypeURLs, sdk.MsgTypeURL(msg))
+	}
+	return info, nil
+}
+
+// txSliceIterator iterates over a snapshot of the transactions of the Cosmos
+// pool.
+type txSliceIterator struct {
+	txs []sdk.Tx
+}
+
+var _ sdkmempool.Iterator = (*txSliceIterator)(nil)
+
+// newTxSliceIterator returns an iterator over the transactions, nil if there
+// are none.
+func newTxSliceIterator(txs []sdk.Tx) sdkmempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &txSliceIterator{txs: txs}
+}
+
+// Next implements sdkmempool.Iterator
+func (it *txSliceIterator) Next() sdkmempool.Iterator {
+	return newTxSliceIterator(it.txs[1:])
+}
+
+// Tx implements sdkmempool.Iterator
+func (it *txSliceIterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
+
+// paginate returns the page of the items requested, with the semantics of the
+// store pagination: the page starts at the key or offset of the request and
+// holds at most limit items, the default limit if zero.
+func paginate[T any](items []T, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	start := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, errors.New("invalid request, invalid pagination key")
+		}
+		start = sdk.BigEndianToUint64(pageReq.Key)
+	}
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count the total like the store pagination when the limit is defaulted
+		countTotal = countTotal || len(pageReq.Key) == 0
+	}
+
+	total := uint64(len(items))
+	if pageReq.Reverse {
+		reversed := make([]T, len(items))
+		for i, item := range items {
+			reversed[len(items)-1-i] = item
+		}
+		items = reversed
+	}
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+	if start >= total {
+		return []T{}, pageRes, nil
+	}
+	end := total
+	if limit < total-start {
+		end = start + limit
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	return items[start:end], pageRes, nil
+}
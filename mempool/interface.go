@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -35,3 +36,10 @@ type VMKeeperI interface {
 type FeeMarketKeeperI interface {
 	GetBlockGasWanted(ctx sdk.Context) uint64
 }
+
+// AccountKeeperI is used to validate replacement Cosmos transactions against the
+// sequence they replace.
+type AccountKeeperI interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
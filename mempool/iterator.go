@@ -21,21 +21,28 @@ import (
 var _ mempool.Iterator = &EVMMempoolIterator{}
 
 // EVMMempoolIterator provides a unified iterator over both EVM and Cosmos transactions in the mempool.
-// It implements priority-based transaction selection, choosing between EVM and Cosmos transactions
-// based on their fee values. The iterator maintains state to track transaction types and ensures
-// proper sequencing during block building.
+// It interleaves the EVM and Cosmos transactions as decided by the block selector of the selection
+// policy, by default based on their fee values. The iterator maintains state to track transaction
+// types and ensures proper sequencing during block building.
 type EVMMempoolIterator struct {
 	/** Mempool Iterators **/
 	evmIterator    *miner.TransactionsByPriceAndNonce
 	cosmosIterator mempool.Iterator
 
+	/** Block Building **/
+	selector BlockSelector
+
 	/** Utils **/
 	logger   log.Logger
 	txConfig client.TxConfig
 
 	/** Chain Params **/
-	bondDenom string
-	chainID   *big.Int
+	bondDenom      string
+	feeDenomOracle msgtypes.FeeDenomOracle
+	chainID        *big.Int
+
+	/** Context of the block being built **/
+	ctx sdk.Context
 
 	/** Blockchain Access **/
 	blockchain *Blockchain
@@ -44,8 +51,10 @@ type EVMMempoolIterator struct {
 // NewEVMMempoolIterator creates a new unified iterator over EVM and Cosmos transactions.
 // It combines iterators from both transaction pools and selects transactions based on fee priority.
 // Returns nil if both iterators are empty or nil. The bondDenom parameter specifies the native
-// token denomination for fee comparisons, and chainId is used for EVM transaction conversion.
-func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, bondDenom string, chainID *big.Int, blockchain *Blockchain) mempool.Iterator {
+// token denomination for fee comparisons, the optional feeDenomOracle values the fees paid in other
+// denoms at the ctx, and chainId is used for EVM transaction conversion.
+// The selector decides which transaction is selected first, a nil selector selects by fee.
+func NewEVMMempoolIterator(ctx sdk.Context, evmIterator *miner.TransactionsByPriceAndNonce, cosmosIterator mempool.Iterator, logger log.Logger, txConfig client.TxConfig, bondDenom string, feeDenomOracle msgtypes.FeeDenomOracle, chainID *big.Int, blockchain *Blockchain, selector BlockSelector) mempool.Iterator {
 	// Check if we have any transactions at all
 	hasEVM := evmIterator != nil && !evmIterator.Empty()
 	hasCosmos := cosmosIterator != nil && cosmosIterator.Tx() != nil
@@ -58,13 +67,20 @@ func NewEVMMempoolIterator(evmIterator *miner.TransactionsByPriceAndNonce, cosmo
 		return nil
 	}
 
+	if selector == nil {
+		selector = tipSelector{}
+	}
+
 	return &EVMMempoolIterator{
 		evmIterator:    evmIterator,
 		cosmosIterator: cosmosIterator,
+		selector:       selector,
 		logger:         logger,
 		txConfig:       txConfig,
 		bondDenom:      bondDenom,
+		feeDenomOracle: feeDenomOracle,
 		chainID:        chainID,
+		ctx:            ctx,
 		blockchain:     blockchain,
 	}
 }
@@ -123,15 +139,14 @@ func (i *EVMMempoolIterator) Tx() sdk.Tx {
 // UTILITY FUNCTIONS
 // =============================================================================
 
-// shouldUseEVM determines which transaction type to prioritize based on fee comparison.
+// shouldUseEVM determines which transaction type to prioritize.
 // Returns true if the EVM transaction should be selected, false if Cosmos transaction should be used.
-// EVM transactions will be prioritized in the following conditions:
-// 1. Cosmos mempool has no transactions
-// 2. EVM mempool has no transactions (fallback to Cosmos)
-// 3. Cosmos transaction has no fee information
-// 4. Cosmos transaction fee denomination doesn't match bond denom
-// 5. Cosmos transaction fee is lower than the EVM transaction fee
-// 6. Cosmos transaction fee overflows when converted to uint256
+// When only one type is available it is selected, otherwise the block selector decides. With the
+// default tip selection policy, EVM transactions will be prioritized in the following conditions:
+// 1. Cosmos transaction has no fee information
+// 2. Cosmos transaction fee denomination doesn't match bond denom
+// 3. Cosmos transaction fee is lower than the EVM transaction fee
+// 4. Cosmos transaction fee overflows when converted to uint256
 func (i *EVMMempoolIterator) shouldUseEVM() bool {
 	// Get next transactions from both iterators
 	nextEVMTx, evmFee := i.getNextEVMTx()
@@ -147,20 +162,30 @@ func (i *EVMMempoolIterator) shouldUseEVM() bool {
 		return true // Use EVM when no Cosmos transaction available
 	}
 
-	// Both have transactions - compare fees
+	// Both have transactions - let the block selector decide
 	// cosmosFee can never be nil, but can be zero if no valid fee found
-	if cosmosFee.IsZero() {
-		i.logger.Debug("Cosmos transaction has no valid fee, preferring EVM", "evm_fee", evmFee.String())
-		return true // Use EVM if Cosmos transaction has no valid fee
-	}
-
-	// Compare fees - prefer EVM unless Cosmos has higher fee
-	cosmosHigher := cosmosFee.Gt(evmFee)
-	i.logger.Debug("comparing transaction fees",
+	i.logger.Debug("comparing transactions",
 		"evm_fee", evmFee.String(),
 		"cosmos_fee", cosmosFee.String())
 
-	return !cosmosHigher
+	return i.selector.SelectEVM(
+		i.evmCandidate(nextEVMTx, evmFee),
+		i.cosmosCandidate(nextCosmosTx, cosmosFee),
+	)
+}
+
+// evmCandidate returns the selection candidate of an EVM transaction
+func (i *EVMMempoolIterator) evmCandidate(tx *txpool.LazyTransaction, fee *uint256.Int) *SelectionCandidate {
+	return &SelectionCandidate{Gas: tx.Gas, EffectiveTip: fee}
+}
+
+// cosmosCandidate returns the selection candidate of a Cosmos transaction
+func (i *EVMMempoolIterator) cosmosCandidate(tx sdk.Tx, fee *uint256.Int) *SelectionCandidate {
+	candidate := &SelectionCandidate{Tx: tx, EffectiveTip: fee}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		candidate.Gas = feeTx.GetGas()
+	}
+	return candidate
 }
 
 // getNextEVMTx retrieves the next EVM transaction and its fee
@@ -231,6 +256,9 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 		// NOTE: EVM transactions are automatically removed by the maintenance loop in the txpool
 		// so we shift instead of popping
 		if i.evmIterator != nil {
+			if nextEVMTx, evmFee := i.getNextEVMTx(); nextEVMTx != nil {
+				i.selector.OnSelected(i.evmCandidate(nextEVMTx, evmFee))
+			}
 			i.evmIterator.Shift()
 		} else {
 			i.logger.Error("EVM iterator is nil but shouldUseEVM returned true")
@@ -239,6 +267,9 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 		i.logger.Debug("advancing Cosmos iterator")
 		// We used Cosmos transaction (or EVM failed), advance Cosmos iterator
 		if i.cosmosIterator != nil {
+			if nextCosmosTx, cosmosFee := i.getNextCosmosTx(); nextCosmosTx != nil {
+				i.selector.OnSelected(i.cosmosCandidate(nextCosmosTx, cosmosFee))
+			}
 			i.cosmosIterator = i.cosmosIterator.Next()
 		} else {
 			i.logger.Error("Cosmos iterator is nil but shouldUseEVM returned false")
@@ -248,6 +279,7 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 
 // extractCosmosEffectiveTip extracts the effective gas tip from a Cosmos transaction
 // This aligns with EVM transaction prioritization by calculating: gas_price - base_fee
+// The fees paid in other denoms than the bond denom are converted by the fee denom oracle, if any.
 func (i *EVMMempoolIterator) extractCosmosEffectiveTip(tx sdk.Tx) *uint256.Int {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -255,14 +287,8 @@ func (i *EVMMempoolIterator) extractCosmosEffectiveTip(tx sdk.Tx) *uint256.Int {
 		return nil // Transaction doesn't implement FeeTx interface
 	}
 
-	bondDenomFeeAmount := math.ZeroInt()
-	fees := feeTx.GetFee()
-	for _, coin := range fees {
-		if coin.Denom == i.bondDenom {
-			i.logger.Debug("found fee in bond denomination", "denom", coin.Denom, "amount", coin.Amount.String())
-			bondDenomFeeAmount = coin.Amount
-		}
-	}
+	bondDenomFeeAmount := msgtypes.FeeAmountInEVMDenom(i.ctx, i.feeDenomOracle, i.bondDenom, feeTx.GetFee())
+	i.logger.Debug("valued fee in bond denomination", "fee", feeTx.GetFee().String(), "amount", bondDenomFeeAmount.String())
 
 	// Calculate gas price: fee_amount / gas_limit
 	gasPrice, overflow := uint256.FromBig(bondDenomFeeAmount.Quo(math.NewIntFromUint64(feeTx.GetGas())).BigInt())
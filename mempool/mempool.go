@@ -33,6 +33,9 @@ const (
 	SubscriberName = "evm"
 	// fallbackBlockGasLimit is the default block gas limit is 0 or missing in genesis file
 	fallbackBlockGasLimit = 100_000_000
+	// DefaultCosmosPriceBump is the default minimum priority bump percentage for
+	// a Cosmos transaction to replace the one of the same signer and sequence
+	DefaultCosmosPriceBump = 10
 )
 
 type (
@@ -49,6 +52,17 @@ type (
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
 
+		/** Cosmos Pool Inspection **/
+		cosmosTxPriority sdkmempool.TxPriority[math.Int]
+		signerExtractor  sdkmempool.SignerExtractionAdapter
+
+		/** Cosmos Tx Replacement **/
+		accountKeeper      AccountKeeperI
+		checkStateContext  func(txBytes []byte) sdk.Context
+		cosmosPriceBump    uint64
+		cancelledCosmosTxs map[string]cosmosTxKey
+		replacedCosmosTxs  map[string]cosmosTxKey
+
 		/** Utils **/
 		logger        log.Logger
 		txConfig      client.TxConfig
@@ -56,6 +70,10 @@ type (
 		blockGasLimit uint64 // Block gas limit from consensus parameters
 		minTip        *uint256.Int
 
+		/** Block Building **/
+		selectionPolicy SelectionPolicy
+		feeDenomOracle  evmtypes.FeeDenomOracle
+
 		/** Verification **/
 		anteHandler sdk.AnteHandler
 
@@ -76,6 +94,24 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	// SelectionPolicy interleaves the EVM and Cosmos transactions in the blocks,
+	// defaults to the TipSelectionPolicy
+	SelectionPolicy SelectionPolicy
+	// FeeDenomOracle optionally values the fees of the Cosmos transactions paid
+	// in other denoms than the EVM denom when prioritizing them
+	FeeDenomOracle evmtypes.FeeDenomOracle
+	// AccountKeeper enables the replacement of Cosmos transactions, it is used
+	// to validate a replacement against the sequence it replaces
+	AccountKeeper AccountKeeperI
+	// CheckStateContext returns a context of the check state, e.g.
+	// BaseApp.GetContextForCheckTx. A Cosmos transaction taking the sequence
+	// after one held in the Cosmos pool is validated against it, so that the
+	// fees of the pooled transactions are accounted for
+	CheckStateContext func(txBytes []byte) sdk.Context
+	// CosmosPriceBump is the minimum priority bump percentage for a Cosmos
+	// transaction to replace the one of the same signer and sequence, defaults
+	// to DefaultCosmosPriceBump
+	CosmosPriceBump uint64
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -159,12 +195,12 @@ func NewExperimentalEVMMempool(
 				if !ok {
 					return math.ZeroInt()
 				}
-				found, coin := cosmosTxFee.GetFee().Find(vmKeeper.GetEvmCoinInfo(ctx).Denom)
-				if !found {
+				feeAmount := evmtypes.FeeAmountInEVMDenom(ctx, config.FeeDenomOracle, vmKeeper.GetEvmCoinInfo(ctx).Denom, cosmosTxFee.GetFee())
+				if !feeAmount.IsPositive() {
 					return math.ZeroInt()
 				}
 
-				gasPrice := coin.Amount.Quo(math.NewIntFromUint64(cosmosTxFee.GetGas()))
+				gasPrice := feeAmount.Quo(math.NewIntFromUint64(cosmosTxFee.GetGas()))
 
 				return gasPrice
 			},
@@ -177,8 +213,21 @@ func NewExperimentalEVMMempool(
 	}
 
 	cosmosPoolConfig.MaxTx = cosmosPoolMaxTx
+	if cosmosPoolConfig.SignerExtractor == nil {
+		cosmosPoolConfig.SignerExtractor = NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter())
+	}
 	cosmosPool = sdkmempool.NewPriorityMempool(*cosmosPoolConfig)
 
+	selectionPolicy := config.SelectionPolicy
+	if selectionPolicy == nil {
+		selectionPolicy = TipSelectionPolicy{}
+	}
+
+	cosmosPriceBump := config.CosmosPriceBump
+	if cosmosPriceBump == 0 {
+		cosmosPriceBump = DefaultCosmosPriceBump
+	}
+
 	evmMempool := &ExperimentalEVMMempool{
 		vmKeeper:      vmKeeper,
 		txPool:        txPool,
@@ -190,6 +239,18 @@ func NewExperimentalEVMMempool(
 		blockGasLimit: config.BlockGasLimit,
 		minTip:        config.MinTip,
 		anteHandler:   config.AnteHandler,
+
+		cosmosTxPriority: cosmosPoolConfig.TxPriority,
+		signerExtractor:  cosmosPoolConfig.SignerExtractor,
+
+		accountKeeper:      config.AccountKeeper,
+		checkStateContext:  config.CheckStateContext,
+		cosmosPriceBump:    cosmosPriceBump,
+		cancelledCosmosTxs: make(map[string]cosmosTxKey),
+		replacedCosmosTxs:  make(map[string]cosmosTxKey),
+
+		selectionPolicy: selectionPolicy,
+		feeDenomOracle:  config.FeeDenomOracle,
 	}
 
 	vmKeeper.SetEvmMempool(evmMempool)
@@ -262,6 +323,9 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 	if len(msgs) != 1 {
 		return fmt.Errorf("%w, got %d", ErrExpectedOneMessage, len(msgs))
 	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok && len(feeTx.FeeGranter()) > 0 {
+		return ErrFeeGrantedEVMTx
+	}
 	for _, msg := range tx.GetMsgs() {
 		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 		if ok {
@@ -280,8 +344,8 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 }
 
 // Select returns a unified iterator over both EVM and Cosmos transactions.
-// The iterator prioritizes transactions based on their fees and manages proper
-// sequencing. The i parameter contains transaction hashes to exclude from selection.
+// The iterator prioritizes transactions as decided by the selection policy, by default
+// based on their fees, and manages proper sequencing. The i parameter contains transaction hashes to exclude from selection.
 func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkmempool.Iterator {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -289,7 +353,7 @@ func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkme
 
 	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
 
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+	combinedIterator := NewEVMMempoolIterator(ctx, evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.feeDenomOracle, m.blockchain.Config().ChainID, m.blockchain, m.selectionPolicy.NewBlockSelector(m.blockGasLimit))
 
 	return combinedIterator
 }
@@ -334,6 +398,13 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 		return err
 	}
 
+	if m.isReplacedCosmosTx(tx) {
+		// the Cosmos pool removes by signer and sequence, which would remove the
+		// replacement holding the sequence instead
+		m.logger.Debug("skipping removal of replaced Cosmos transaction")
+		return nil
+	}
+
 	m.logger.Debug("removing Cosmos transaction")
 	err = m.cosmosPool.Remove(tx)
 	if err != nil {
@@ -387,13 +458,54 @@ func (m *ExperimentalEVMMempool) SelectBy(goCtx context.Context, i [][]byte, f f
 
 	evmIterator, cosmosIterator := m.getIterators(goCtx, i)
 
-	combinedIterator := NewEVMMempoolIterator(evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.blockchain.Config().ChainID, m.blockchain)
+	combinedIterator := NewEVMMempoolIterator(ctx, evmIterator, cosmosIterator, m.logger, m.txConfig, m.vmKeeper.GetEvmCoinInfo(ctx).Denom, m.feeDenomOracle, m.blockchain.Config().ChainID, m.blockchain, m.selectionPolicy.NewBlockSelector(m.blockGasLimit))
 
 	for combinedIterator != nil && f(combinedIterator.Tx()) {
 		combinedIterator = combinedIterator.Next()
 	}
 }
 
+// PendingEVMTransactions returns the EVM transactions that would be included in
+// the next block, i.e. the executable transactions of the pool ordered by price
+// and nonce, as many as fit in the block gas limit. Once a transaction of an
+// account doesn't fit, the following transactions of the account are skipped too,
+// since they depend on its nonce.
+func (m *ExperimentalEVMMempool) PendingEVMTransactions() ([]*ethtypes.Transaction, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest context: %w", err)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var (
+		txs     []*ethtypes.Transaction
+		gasLeft = m.blockGasLimit
+		it      = m.getEVMIterator(ctx)
+	)
+	for {
+		lazyTx, _ := it.Peek()
+		if lazyTx == nil {
+			break
+		}
+		if lazyTx.Gas > gasLeft {
+			it.Pop()
+			continue
+		}
+		tx := lazyTx.Resolve()
+		if tx == nil {
+			// the transaction was removed from the pool in the meantime
+			it.Pop()
+			continue
+		}
+		txs = append(txs, tx)
+		gasLeft -= lazyTx.Gas
+		it.Shift()
+	}
+	return txs, nil
+}
+
 // SetEventBus sets CometBFT event bus to listen for new block header event.
 func (m *ExperimentalEVMMempool) SetEventBus(eventBus *cmttypes.EventBus) {
 	if m.HasEventBus() {
@@ -434,6 +546,8 @@ func (m *ExperimentalEVMMempool) Close() error {
 
 // getEVMMessage validates that the transaction contains exactly one message and returns it if it's an EVM message.
 // Returns an error if the transaction has no messages, multiple messages, or the single message is not an EVM transaction.
+// EVM transactions whose fees are paid by a fee granter are also rejected, since the EVM pool only keeps the
+// Ethereum transaction and would drop the fee granter, so they are handled by the Cosmos pool instead.
 func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereumTx, error) {
 	msgs := tx.GetMsgs()
 	if len(msgs) == 0 {
@@ -446,6 +560,9 @@ func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereum
 	if !ok {
 		return nil, ErrNotEVMTransaction
 	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok && len(feeTx.FeeGranter()) > 0 {
+		return nil, ErrFeeGrantedEVMTx
+	}
 	return ethMsg, nil
 }
 
@@ -454,14 +571,24 @@ func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereum
 // while setting up the Cosmos iterator with the provided exclusion list.
 func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte) (*miner.TransactionsByPriceAndNonce, sdkmempool.Iterator) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	m.logger.Debug("getting iterators")
+
+	orderedEVMPendingTxes := m.getEVMIterator(ctx)
+	cosmosPendingTxes := m.cosmosPool.Select(ctx, i)
+
+	return orderedEVMPendingTxes, cosmosPendingTxes
+}
+
+// getEVMIterator returns an iterator over the executable EVM transactions paying
+// at least the current base fee, ordered by effective tip and nonce.
+func (m *ExperimentalEVMMempool) getEVMIterator(ctx sdk.Context) *miner.TransactionsByPriceAndNonce {
 	baseFee := m.vmKeeper.GetBaseFee(ctx)
 	var baseFeeUint *uint256.Int
 	if baseFee != nil {
 		baseFeeUint = uint256.MustFromBig(baseFee)
 	}
 
-	m.logger.Debug("getting iterators")
-
 	pendingFilter := txpool.PendingFilter{
 		MinTip:       m.minTip,
 		BaseFee:      baseFeeUint,
@@ -470,11 +597,7 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 		OnlyBlobTxs:  false,
 	}
 	evmPendingTxes := m.txPool.Pending(pendingFilter)
-	orderedEVMPendingTxes := miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxes, baseFee)
-
-	cosmosPendingTxes := m.cosmosPool.Select(ctx, i)
-
-	return orderedEVMPendingTxes, cosmosPendingTxes
+	return miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxes, baseFee)
 }
 
 // broadcastEVMTransactions converts Ethereum transactions to Cosmos SDK format and broadcasts them.
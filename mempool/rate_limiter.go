@@ -0,0 +1,89 @@
+package mempool
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// peerRateLimitMeter counts the queued transactions rejected by the peer rate limiter
+var peerRateLimitMeter = metrics.NewRegisteredMeter("txpool/queued/peerratelimit", nil)
+
+// PeerRateLimiter limits the number of queued transactions, i.e. with a nonce
+// gap, that each peer can submit over RPC per minute. Peers are identified by
+// their IP address. Each peer has a bucket of tokens refilled continuously up
+// to the limit, a queued transaction takes one token.
+type PeerRateLimiter struct {
+	mtx       sync.Mutex
+	limit     float64
+	buckets   map[string]*peerBucket
+	lastPrune time.Time
+}
+
+type peerBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewPeerRateLimiter creates a rate limiter allowing limit queued transactions
+// per minute to each peer.
+func NewPeerRateLimiter(limit uint64) *PeerRateLimiter {
+	return &PeerRateLimiter{
+		limit:   float64(limit),
+		buckets: make(map[string]*peerBucket),
+	}
+}
+
+// Allow takes a token of the peer with the given remote address, returns false
+// if the peer exceeded its rate. Peers without address, e.g. the IPC clients,
+// are not limited.
+func (l *PeerRateLimiter) Allow(remoteAddr string) bool {
+	if remoteAddr == "" {
+		return true
+	}
+	peer := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		peer = host
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	bucket, ok := l.buckets[peer]
+	if !ok {
+		bucket = &peerBucket{tokens: l.limit, updated: now}
+		l.buckets[peer] = bucket
+	}
+	bucket.tokens = l.refill(bucket, now)
+	bucket.updated = now
+	if bucket.tokens < 1 {
+		peerRateLimitMeter.Mark(1)
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// refill returns the tokens of the bucket refilled until now.
+func (l *PeerRateLimiter) refill(bucket *peerBucket, now time.Time) float64 {
+	return min(l.limit, bucket.tokens+now.Sub(bucket.updated).Minutes()*l.limit)
+}
+
+// prune removes the buckets refilled to the limit once per minute, they are
+// the same as the new ones.
+func (l *PeerRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for peer, bucket := range l.buckets {
+		if l.refill(bucket, now) >= l.limit {
+			delete(l.buckets, peer)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool"
+)
+
+func TestPeerRateLimiter(t *testing.T) {
+	limiter := mempool.NewPeerRateLimiter(2)
+
+	// the peers are identified by their IP address, regardless of the port
+	require.True(t, limiter.Allow("10.0.0.1:1000"))
+	require.True(t, limiter.Allow("10.0.0.1:2000"))
+	require.False(t, limiter.Allow("10.0.0.1:3000"))
+
+	// the other peers have their own rate
+	require.True(t, limiter.Allow("10.0.0.2:1000"))
+	require.True(t, limiter.Allow("[::1]:1000"))
+
+	// the peers without address are not limited
+	for range 3 {
+		require.True(t, limiter.Allow(""))
+	}
+}
@@ -0,0 +1,269 @@
+package mempool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// cosmosTxKey identifies a Cosmos transaction by the signer the Cosmos pool
+// orders it by and its sequence.
+type cosmosTxKey struct {
+	signer   string
+	sequence uint64
+}
+
+// ReplaceCosmosTx inserts a Cosmos transaction whose sequence is already taken in the check state, i.e. which
+// failed CheckTx with a wrong sequence. The transaction replaces the transaction of the same signer and sequence
+// held in the Cosmos pool if its priority is higher by at least the price bump percentage. If the Cosmos pool
+// doesn't hold the sequence anymore, because the transaction holding it was cancelled or, being a replacement,
+// was removed on recheck while the transaction it replaced took the sequence again, the transaction takes it as
+// long as the previous sequence of the signer is committed or held in the Cosmos pool.
+// The transaction is validated by the ante handler with the sequence of the signer set to the sequence of the
+// transaction: against the check state if it takes the sequence after one held in the Cosmos pool, so that the fees
+// of the pooled transactions are accounted for, and against the latest state otherwise, as the check state already
+// accounts for the fee of the transaction it replaces. ErrNotReplaceable is returned if the transaction cannot take
+// the sequence, in which case the sequence error stands.
+func (m *ExperimentalEVMMempool) ReplaceCosmosTx(txBytes []byte) error {
+	if m.anteHandler == nil || m.accountKeeper == nil {
+		return ErrNotReplaceable
+	}
+
+	tx, err := m.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return err
+	}
+	if _, err := m.getEVMMessage(tx); err == nil || errors.Is(err, ErrNoMessages) {
+		return ErrNotReplaceable
+	}
+	if unordered, ok := tx.(sdk.TxWithUnordered); ok && unordered.GetUnordered() {
+		return ErrNotReplaceable
+	}
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(signers) == 0 {
+		return ErrNotReplaceable
+	}
+	signer, sequence := signers[0].Signer, signers[0].Sequence
+
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return fmt.Errorf("failed to get latest context: %w", err)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.pruneCosmosTxKeys(ctx)
+
+	account := m.accountKeeper.GetAccount(ctx, signer)
+	if account == nil || sequence < account.GetSequence() {
+		// the sequence is already committed
+		return ErrNotReplaceable
+	}
+	hash := cmttypes.Tx(txBytes).Hash()
+	if _, ok := m.cancelledCosmosTxs[string(hash)]; ok {
+		return ErrNotReplaceable
+	}
+
+	held, previousHeld := m.cosmosTxAt(ctx, signer, sequence)
+	validationCtx := ctx
+	var heldBytes []byte
+	if held == nil {
+		if sequence > account.GetSequence() && !previousHeld {
+			return ErrNotReplaceable
+		}
+		if previousHeld && m.checkStateContext != nil {
+			validationCtx = m.checkStateContext(txBytes)
+			if account = m.accountKeeper.GetAccount(validationCtx, signer); account == nil {
+				return ErrNotReplaceable
+			}
+		}
+	} else {
+		heldBytes, err = m.txConfig.TxEncoder()(held)
+		if err != nil {
+			return fmt.Errorf("failed to encode Cosmos transaction: %w", err)
+		}
+		if bytes.Equal(heldBytes, txBytes) {
+			return nil
+		}
+
+		priority := m.cosmosTxPriority.GetTxPriority(ctx, tx)
+		heldPriority := m.cosmosTxPriority.GetTxPriority(ctx, held)
+		if isReplaceUnderpriced(priority, heldPriority, m.cosmosPriceBump) {
+			return fmt.Errorf("%w: priority %s, replaced priority %s, price bump %d%%", ErrReplaceUnderpriced, priority, heldPriority, m.cosmosPriceBump)
+		}
+	}
+
+	if err := m.validateCosmosTxAtSequence(validationCtx, tx, txBytes, account, sequence); err != nil {
+		return err
+	}
+
+	if held != nil {
+		if err := m.cosmosPool.Remove(held); err != nil {
+			return err
+		}
+	}
+	if err := m.cosmosPool.Insert(ctx, tx); err != nil {
+		if held != nil {
+			if err := m.cosmosPool.Insert(ctx, held); err != nil {
+				m.logger.Error("failed to reinsert replaced Cosmos transaction", "error", err)
+			}
+		}
+		return err
+	}
+	if held != nil {
+		// the replaced transaction may still be in the CometBFT mempool, failing its
+		// recheck must not remove the replacement
+		m.replacedCosmosTxs[string(cmttypes.Tx(heldBytes).Hash())] = cosmosTxKey{signer: string(signer), sequence: sequence}
+	}
+	delete(m.replacedCosmosTxs, string(hash))
+	m.logger.Debug("Cosmos transaction took its sequence", "tx_hash", fmt.Sprintf("%X", hash), "signer", signer, "sequence", sequence, "replaced", held != nil)
+	return nil
+}
+
+// CancelCosmosTx removes the Cosmos transaction of the signer with the sequence from the mempool, along with the
+// following transactions of the signer, which cannot be included without it, and returns the removed transactions.
+// The cancellation is local to the node: the transactions already broadcast may still be included by other
+// validators, and the signer can take the sequence again with a new transaction.
+func (m *ExperimentalEVMMempool) CancelCosmosTx(signer sdk.AccAddress, sequence uint64) ([]CosmosTxInfo, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest context: %w", err)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.pruneCosmosTxKeys(ctx)
+
+	var (
+		txs   []sdk.Tx
+		found bool
+	)
+	m.cosmosPool.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		signers, err := m.signerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 || !signers[0].Signer.Equals(signer) || signers[0].Sequence < sequence {
+			return true
+		}
+		txs = append(txs, tx)
+		found = found || signers[0].Sequence == sequence
+		return true
+	})
+	if !found {
+		return nil, fmt.Errorf("%w: signer %s, sequence %d", ErrCosmosTxNotFound, signer, sequence)
+	}
+
+	cancelled := make([]CosmosTxInfo, 0, len(txs))
+	for _, tx := range txs {
+		info, err := m.cosmosTxInfo(ctx, tx)
+		if err != nil {
+			return cancelled, err
+		}
+		if err := m.cosmosPool.Remove(tx); err != nil {
+			return cancelled, fmt.Errorf("failed to remove Cosmos transaction %X: %w", info.Hash, err)
+		}
+		if m.accountKeeper != nil {
+			// the transaction may still be in the CometBFT mempool, it must not take its
+			// sequence again when it fails the recheck
+			m.cancelledCosmosTxs[string(info.Hash)] = cosmosTxKey{signer: string(signer), sequence: info.Sequence}
+		}
+		cancelled = append(cancelled, info)
+	}
+	m.logger.Info("cancelled Cosmos transactions", "signer", signer, "sequence", sequence, "count", len(cancelled))
+	return cancelled, nil
+}
+
+// cosmosTxAt returns the Cosmos transaction of the signer with the sequence held in the Cosmos pool, if any, and
+// whether the Cosmos pool holds the previous sequence of the signer.
+func (m *ExperimentalEVMMempool) cosmosTxAt(ctx sdk.Context, signer sdk.AccAddress, sequence uint64) (held sdk.Tx, previousHeld bool) {
+	// PriorityNonceMempool.NextSenderTx cannot be used as a shortcut, it panics once all the transactions of
+	// the sender were removed
+	if m.cosmosPool.CountTx() == 0 {
+		return nil, false
+	}
+
+	m.cosmosPool.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		signers, err := m.signerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 || !signers[0].Signer.Equals(signer) {
+			return true
+		}
+		switch {
+		case signers[0].Sequence == sequence:
+			held = tx
+		case sequence > 0 && signers[0].Sequence == sequence-1:
+			previousHeld = true
+		}
+		return held == nil || (sequence > 0 && !previousHeld)
+	})
+	return held, previousHeld
+}
+
+// validateCosmosTxAtSequence runs the ante handler in CheckTx mode on the Cosmos transaction against a branch of
+// the state of the context, in which the sequence of the account of the signer is set to the sequence of the transaction.
+func (m *ExperimentalEVMMempool) validateCosmosTxAtSequence(ctx sdk.Context, tx sdk.Tx, txBytes []byte, account sdk.AccountI, sequence uint64) error {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := account.SetSequence(sequence); err != nil {
+		return err
+	}
+	m.accountKeeper.SetAccount(cacheCtx, account)
+
+	_, err := m.anteHandler(cacheCtx.WithIsCheckTx(true).WithTxBytes(txBytes), tx, false)
+	return err
+}
+
+// isReplacedCosmosTx returns whether the Cosmos transaction was replaced by the
+// one holding its sequence in the Cosmos pool. The caller must hold the mempool
+// lock.
+func (m *ExperimentalEVMMempool) isReplacedCosmosTx(tx sdk.Tx) bool {
+	if len(m.replacedCosmosTxs) == 0 {
+		return false
+	}
+	txBytes, err := m.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return false
+	}
+	key, ok := m.replacedCosmosTxs[string(cmttypes.Tx(txBytes).Hash())]
+	if !ok {
+		return false
+	}
+
+	held, _ := m.cosmosTxAt(m.blockchain.latestCtx, sdk.AccAddress(key.signer), key.sequence)
+	if held == nil {
+		return true
+	}
+	heldBytes, err := m.txConfig.TxEncoder()(held)
+	return err != nil || !bytes.Equal(heldBytes, txBytes)
+}
+
+// pruneCosmosTxKeys forgets the cancelled and replaced Cosmos transactions whose
+// sequence is committed.
+func (m *ExperimentalEVMMempool) pruneCosmosTxKeys(ctx sdk.Context) {
+	if m.accountKeeper == nil {
+		return
+	}
+	for _, txs := range []map[string]cosmosTxKey{m.cancelledCosmosTxs, m.replacedCosmosTxs} {
+		for hash, key := range txs {
+			account := m.accountKeeper.GetAccount(ctx, sdk.AccAddress(key.signer))
+			if account == nil || key.sequence < account.GetSequence() {
+				delete(txs, hash)
+			}
+		}
+	}
+}
+
+// isReplaceUnderpriced returns whether a Cosmos transaction with the priority
+// doesn't bump the priority of the transaction it replaces by at least the
+// price bump percentage.
+func isReplaceUnderpriced(priority, replacedPriority math.Int, priceBump uint64) bool {
+	threshold := replacedPriority.Mul(math.NewIntFromUint64(100 + priceBump)).QuoRaw(100)
+	return priority.LTE(replacedPriority) || priority.LT(threshold)
+}
@@ -0,0 +1,191 @@
+package mempool
+
+import (
+	"math/bits"
+
+	"github.com/holiman/uint256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SelectionPolicyTip selects the transactions by effective tip
+	SelectionPolicyTip = "tip"
+	// SelectionPolicyLanes selects the transactions by effective tip within
+	// the gas quotas of the EVM and Cosmos lanes
+	SelectionPolicyLanes = "lanes"
+)
+
+var (
+	_ SelectionPolicy = TipSelectionPolicy{}
+	_ SelectionPolicy = LaneSelectionPolicy{}
+	_ SelectionPolicy = ReservedSpaceSelectionPolicy{}
+)
+
+// SelectionPolicy defines how the transactions of the EVM and Cosmos pools are
+// interleaved in the blocks built from the mempool.
+type SelectionPolicy interface {
+	// NewBlockSelector returns the selector of the transactions of a new block
+	// with the given gas limit.
+	NewBlockSelector(blockGasLimit uint64) BlockSelector
+}
+
+// BlockSelector selects the transactions of a single block. Both pools are
+// ordered on their own, the selector decides which of their next transactions
+// is selected first.
+type BlockSelector interface {
+	// SelectEVM returns true if the next EVM transaction is selected before the
+	// next Cosmos transaction.
+	SelectEVM(evmTx, cosmosTx *SelectionCandidate) bool
+	// OnSelected is called once a transaction is selected for the block.
+	OnSelected(tx *SelectionCandidate)
+}
+
+// SelectionCandidate is the next transaction of the EVM or the Cosmos pool.
+type SelectionCandidate struct {
+	// Tx is the Cosmos transaction, nil for an EVM transaction
+	Tx sdk.Tx
+	// Gas is the gas limit of the transaction
+	Gas uint64
+	// EffectiveTip is the effective tip per gas of the transaction, zero if the
+	// Cosmos transaction doesn't pay its fees in the EVM denom
+	EffectiveTip *uint256.Int
+}
+
+// IsEVM returns true if the candidate is an EVM transaction.
+func (c *SelectionCandidate) IsEVM() bool {
+	return c.Tx == nil
+}
+
+// TipSelectionPolicy selects the transaction with the highest effective tip,
+// the EVM transaction is selected on a tie. This is the default policy.
+type TipSelectionPolicy struct{}
+
+// NewBlockSelector implements SelectionPolicy.
+func (TipSelectionPolicy) NewBlockSelector(uint64) BlockSelector {
+	return tipSelector{}
+}
+
+type tipSelector struct{}
+
+func (tipSelector) SelectEVM(evmTx, cosmosTx *SelectionCandidate) bool {
+	return !cosmosTx.EffectiveTip.Gt(evmTx.EffectiveTip)
+}
+
+func (tipSelector) OnSelected(*SelectionCandidate) {}
+
+// LaneSelectionPolicy splits the block gas into an EVM and a Cosmos lane, in
+// proportion to their weights. The transactions are selected by effective tip
+// while they fit in the gas quota of their lane, a lane out of its quota
+// yields to the other one. Once no transaction fits in its quota, the rest of
+// the block is filled by effective tip.
+type LaneSelectionPolicy struct {
+	EVMWeight    uint64
+	CosmosWeight uint64
+}
+
+// NewBlockSelector implements SelectionPolicy.
+func (p LaneSelectionPolicy) NewBlockSelector(blockGasLimit uint64) BlockSelector {
+	s := &laneSelector{}
+	if total := p.EVMWeight + p.CosmosWeight; total > 0 {
+		s.evmQuota = gasShare(blockGasLimit, p.EVMWeight, total)
+		s.cosmosQuota = gasShare(blockGasLimit, p.CosmosWeight, total)
+	}
+	return s
+}
+
+type laneSelector struct {
+	evmQuota, cosmosQuota uint64
+	evmUsed, cosmosUsed   uint64
+}
+
+func (s *laneSelector) SelectEVM(evmTx, cosmosTx *SelectionCandidate) bool {
+	evmFits := fitsIn(s.evmUsed, evmTx.Gas, s.evmQuota)
+	cosmosFits := fitsIn(s.cosmosUsed, cosmosTx.Gas, s.cosmosQuota)
+	if evmFits != cosmosFits {
+		return evmFits
+	}
+	return tipSelector{}.SelectEVM(evmTx, cosmosTx)
+}
+
+func (s *laneSelector) OnSelected(tx *SelectionCandidate) {
+	if tx.IsEVM() {
+		s.evmUsed += tx.Gas
+	} else {
+		s.cosmosUsed += tx.Gas
+	}
+}
+
+// ReservedSpaceSelectionPolicy reserves a percentage of the block gas to the
+// Cosmos transactions whose messages are all of the reserved types, e.g. the
+// IBC packets of the relayers. Such a transaction is selected before the EVM
+// transaction while it fits in the reserved gas, the other transactions are
+// selected by the base policy.
+//
+// The Cosmos pool keeps its own ordering, a reserved transaction is only
+// considered once it is the next transaction of the Cosmos pool.
+type ReservedSpaceSelectionPolicy struct {
+	Base            SelectionPolicy
+	ReservedPercent uint64
+	MsgTypeURLs     []string
+}
+
+// NewBlockSelector implements SelectionPolicy.
+func (p ReservedSpaceSelectionPolicy) NewBlockSelector(blockGasLimit uint64) BlockSelector {
+	msgTypeURLs := make(map[string]struct{}, len(p.MsgTypeURLs))
+	for _, typeURL := range p.MsgTypeURLs {
+		msgTypeURLs[typeURL] = struct{}{}
+	}
+	return &reservedSpaceSelector{
+		base:        p.Base.NewBlockSelector(blockGasLimit),
+		reservedGas: gasShare(blockGasLimit, min(p.ReservedPercent, 100), 100),
+		msgTypeURLs: msgTypeURLs,
+	}
+}
+
+type reservedSpaceSelector struct {
+	base        BlockSelector
+	reservedGas uint64
+	usedGas     uint64
+	msgTypeURLs map[string]struct{}
+}
+
+func (s *reservedSpaceSelector) SelectEVM(evmTx, cosmosTx *SelectionCandidate) bool {
+	if s.isReserved(cosmosTx) && fitsIn(s.usedGas, cosmosTx.Gas, s.reservedGas) {
+		return false
+	}
+	return s.base.SelectEVM(evmTx, cosmosTx)
+}
+
+func (s *reservedSpaceSelector) OnSelected(tx *SelectionCandidate) {
+	if s.isReserved(tx) {
+		s.usedGas += tx.Gas
+	}
+	s.base.OnSelected(tx)
+}
+
+// isReserved returns true if all the messages of a Cosmos transaction are of
+// the reserved types.
+func (s *reservedSpaceSelector) isReserved(tx *SelectionCandidate) bool {
+	if tx.IsEVM() || len(tx.Tx.GetMsgs()) == 0 {
+		return false
+	}
+	for _, msg := range tx.Tx.GetMsgs() {
+		if _, ok := s.msgTypeURLs[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// gasShare returns the share numerator/denominator of the gas, without overflow.
+func gasShare(gas, numerator, denominator uint64) uint64 {
+	hi, lo := bits.Mul64(gas, numerator)
+	share, _ := bits.Div64(hi, lo, denominator)
+	return share
+}
+
+// fitsIn returns true if the gas fits in the quota on top of the used gas.
+func fitsIn(used, gas, quota uint64) bool {
+	return used <= quota && gas <= quota-used
+}
@@ -0,0 +1,88 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockMsgsTx struct {
+	msgs []sdk.Msg
+}
+
+func (m mockMsgsTx) GetMsgs() []sdk.Msg { return m.msgs }
+func (m mockMsgsTx) GetMsgsV2() ([]protov2.Message, error) {
+	return []protov2.Message{}, nil
+}
+
+func evmCandidate(gas, tip uint64) *mempool.SelectionCandidate {
+	return &mempool.SelectionCandidate{Gas: gas, EffectiveTip: uint256.NewInt(tip)}
+}
+
+func cosmosCandidate(gas, tip uint64, msgs ...sdk.Msg) *mempool.SelectionCandidate {
+	return &mempool.SelectionCandidate{Tx: mockMsgsTx{msgs: msgs}, Gas: gas, EffectiveTip: uint256.NewInt(tip)}
+}
+
+func TestTipSelectionPolicy(t *testing.T) {
+	selector := mempool.TipSelectionPolicy{}.NewBlockSelector(1000)
+
+	require.True(t, selector.SelectEVM(evmCandidate(100, 2), cosmosCandidate(100, 1)))
+	require.True(t, selector.SelectEVM(evmCandidate(100, 2), cosmosCandidate(100, 2)))
+	require.False(t, selector.SelectEVM(evmCandidate(100, 2), cosmosCandidate(100, 3)))
+	require.True(t, selector.SelectEVM(evmCandidate(100, 0), cosmosCandidate(100, 0)))
+}
+
+func TestLaneSelectionPolicy(t *testing.T) {
+	selector := mempool.LaneSelectionPolicy{EVMWeight: 3, CosmosWeight: 1}.NewBlockSelector(1000)
+
+	// both lanes have gas left, the transactions are selected by tip
+	require.False(t, selector.SelectEVM(evmCandidate(700, 1), cosmosCandidate(200, 2)))
+	require.True(t, selector.SelectEVM(evmCandidate(700, 2), cosmosCandidate(200, 1)))
+	selector.OnSelected(evmCandidate(700, 2))
+
+	// the EVM lane is out of its quota of 750, the Cosmos lane is selected even
+	// with a lower tip
+	require.False(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(200, 1)))
+	selector.OnSelected(cosmosCandidate(200, 1))
+
+	// the Cosmos lane is out of its quota of 250 too, the rest of the block is
+	// filled by tip
+	require.True(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(100, 1)))
+	require.False(t, selector.SelectEVM(evmCandidate(100, 1), cosmosCandidate(100, 5)))
+
+	// the quotas don't overflow with an unlimited block gas
+	selector = mempool.LaneSelectionPolicy{EVMWeight: 1, CosmosWeight: 1}.NewBlockSelector(^uint64(0))
+	selector.OnSelected(cosmosCandidate(^uint64(0)/2, 1))
+	require.True(t, selector.SelectEVM(evmCandidate(100, 1), cosmosCandidate(100, 5)))
+}
+
+func TestReservedSpaceSelectionPolicy(t *testing.T) {
+	var (
+		send  = &banktypes.MsgSend{}
+		multi = &banktypes.MsgMultiSend{}
+		other = &banktypes.MsgUpdateParams{}
+	)
+	selector := mempool.ReservedSpaceSelectionPolicy{
+		Base:            mempool.TipSelectionPolicy{},
+		ReservedPercent: 30,
+		MsgTypeURLs:     []string{sdk.MsgTypeURL(send), sdk.MsgTypeURL(multi)},
+	}.NewBlockSelector(1000)
+
+	// the transactions of the reserved types are selected first while they fit
+	// in the reserved gas
+	require.False(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(200, 0, send, multi)))
+	selector.OnSelected(cosmosCandidate(200, 0, send, multi))
+	require.True(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(200, 0, send)))
+
+	// the other transactions are selected by the base policy
+	require.True(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(50, 0, send, other)))
+	require.False(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(50, 6, other)))
+	require.False(t, selector.SelectEVM(evmCandidate(100, 5), cosmosCandidate(100, 0, send)))
+}
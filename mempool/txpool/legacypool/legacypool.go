@@ -18,7 +18,9 @@
 package legacypool
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 	"maps"
 	"math/big"
 	"slices"
@@ -75,6 +77,10 @@ var (
 	// ErrFutureReplacePending is returned if a future transaction replaces a pending
 	// one. Future transactions should only be able to replace other future transactions.
 	ErrFutureReplacePending = errors.New("future transaction tries to replace pending")
+
+	// ErrNonceGapTooLarge is returned if the nonce of a transaction is further
+	// ahead of its sender's pending nonce than the configured maximum gap.
+	ErrNonceGapTooLarge = errors.New("nonce gap too large")
 )
 
 var (
@@ -95,6 +101,8 @@ var (
 	queuedRateLimitMeter = metrics.NewRegisteredMeter("txpool/queued/ratelimit", nil) // Dropped due to rate limiting
 	queuedNofundsMeter   = metrics.NewRegisteredMeter("txpool/queued/nofunds", nil)   // Dropped due to out-of-funds
 	queuedEvictionMeter  = metrics.NewRegisteredMeter("txpool/queued/eviction", nil)  // Dropped due to lifetime
+	queuedNonceGapMeter  = metrics.NewRegisteredMeter("txpool/queued/noncegap", nil)  // Rejected due to the nonce gap limit
+	queuedUnfundedMeter  = metrics.NewRegisteredMeter("txpool/queued/unfunded", nil)  // Rejected due to the queued costs exceeding the balance
 
 	// General tx metrics
 	knownTxMeter       = metrics.NewRegisteredMeter("txpool/known", nil)
@@ -137,9 +145,9 @@ type BlockChain interface {
 
 // Config are the configuration parameters of the transaction pool.
 type Config struct {
-	Locals    []common.Address // Addresses that should be treated by default as local
+	Locals    []common.Address // Addresses whose transactions are journaled, all addresses if empty
 	NoLocals  bool             // Whether local transaction handling should be disabled
-	Journal   string           // Journal of local transactions to survive node restarts
+	Journal   string           // Journal of local transactions to survive node restarts, disabled if empty
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
@@ -151,11 +159,14 @@ type Config struct {
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
 
 	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+
+	MaxNonceGap        uint64 // Maximum gap between the nonce of a transaction and its sender's pending nonce, unlimited if zero
+	RequireQueuedFunds bool   // Whether the balance of a sender must cover the cost of its queued transactions too
+	GapEviction        bool   // Whether the queued transactions farthest from their sender's pending nonce are evicted first
 }
 
 // DefaultConfig contains the default configurations for the transaction pool.
 var DefaultConfig = Config{
-	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
 
 	PriceLimit: 1,
@@ -173,6 +184,10 @@ var DefaultConfig = Config{
 // unreasonable or unworkable.
 func (config *Config) sanitize() Config {
 	conf := *config
+	if conf.Rejournal < time.Second {
+		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
+		conf.Rejournal = time.Second
+	}
 	if conf.PriceLimit < 1 {
 		log.Warn("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultConfig.PriceLimit)
 		conf.PriceLimit = DefaultConfig.PriceLimit
@@ -239,6 +254,10 @@ type LegacyPool struct {
 	pendingNonces *noncer                      // Pending state tracking virtual nonces
 	reserver      txpool.Reserver              // Address reserver to ensure exclusivity across subpools
 
+	locals      *accountSet // Set of accounts whose transactions are journaled, all if empty
+	journal     *journal    // Journal of transactions to back up to disk
+	journalOnce sync.Once   // Loads the journal on the first reset
+
 	pending map[common.Address]*list     // All currently processable transactions
 	queue   map[common.Address]*list     // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
@@ -287,6 +306,11 @@ func New(config Config, chain BlockChain) *LegacyPool {
 	}
 	pool.priced = newPricedList(pool.all)
 
+	pool.locals = newAccountSet(pool.signer, config.Locals...)
+	if !config.NoLocals && config.Journal != "" {
+		pool.journal = newTxJournal(config.Journal)
+	}
+
 	return pool
 }
 
@@ -343,11 +367,13 @@ func (pool *LegacyPool) loop() {
 		prevPending, prevQueued, prevStales int
 
 		// Start the stats reporting and transaction eviction tickers
-		report = time.NewTicker(statsReportInterval)
-		evict  = time.NewTicker(evictionInterval)
+		report  = time.NewTicker(statsReportInterval)
+		evict   = time.NewTicker(evictionInterval)
+		journal = time.NewTicker(pool.config.Rejournal)
 	)
 	defer report.Stop()
 	defer evict.Stop()
+	defer journal.Stop()
 
 	// Notify tests that the init phase is done
 	close(pool.initDoneCh)
@@ -383,6 +409,19 @@ func (pool *LegacyPool) loop() {
 				}
 			}
 			pool.mu.Unlock()
+
+		// Handle local transaction journal rotation
+		case <-journal.C:
+			if pool.journal != nil {
+				pool.mu.Lock()
+				// the journal isn't rotated until it is loaded, not to overwrite it
+				if pool.journal.active() {
+					if err := pool.journal.rotate(pool.local()); err != nil {
+						log.Warn("Failed to rotate transaction journal", "err", err)
+					}
+				}
+				pool.mu.Unlock()
+			}
 		}
 	}
 }
@@ -393,6 +432,12 @@ func (pool *LegacyPool) Close() error {
 	close(pool.reorgShutdownCh)
 	pool.wg.Wait()
 
+	if pool.journal != nil {
+		pool.mu.Lock()
+		pool.journal.close()
+		pool.mu.Unlock()
+	}
+
 	log.Info("Transaction pool stopped")
 	return nil
 }
@@ -402,6 +447,71 @@ func (pool *LegacyPool) Close() error {
 func (pool *LegacyPool) Reset(oldHead, newHead *types.Header) {
 	wait := pool.requestReset(oldHead, newHead)
 	<-wait
+
+	// The journal is loaded on the first reset, the state of the chain isn't
+	// available to validate the journaled transactions when the pool is created.
+	if pool.journal != nil {
+		pool.journalOnce.Do(pool.loadJournal)
+	}
+}
+
+// loadJournal adds the journaled transactions to the pool and regenerates the
+// journal with the contents of the pool.
+func (pool *LegacyPool) loadJournal() {
+	if err := pool.journal.load(func(txs []*types.Transaction) []error {
+		return pool.Add(txs, false)
+	}); err != nil {
+		log.Warn("Failed to load transaction journal", "err", err)
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if err := pool.journal.rotate(pool.local()); err != nil {
+		log.Warn("Failed to rotate transaction journal", "err", err)
+	}
+}
+
+// local retrieves all currently known transactions of the journaled accounts,
+// grouped by origin account and sorted by nonce. The returned transaction set
+// is a copy and can be freely modified by calling code.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) local() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for addr, list := range pool.pending {
+		if pool.isLocal(addr) {
+			txs[addr] = append(txs[addr], list.Flatten()...)
+		}
+	}
+	for addr, list := range pool.queue {
+		if pool.isLocal(addr) {
+			txs[addr] = append(txs[addr], list.Flatten()...)
+		}
+	}
+	for _, list := range txs {
+		sort.Sort(types.TxByNonce(list))
+	}
+	return txs
+}
+
+// isLocal returns true if the transactions of the account are journaled.
+func (pool *LegacyPool) isLocal(addr common.Address) bool {
+	return len(pool.locals.accounts) == 0 || pool.locals.contains(addr)
+}
+
+// journalTx adds the specified transaction to the local disk journal if it is
+// deemed to have been sent from a journaled account.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) journalTx(from common.Address, tx *types.Transaction) {
+	// Only journal if it's enabled, loaded and the transaction is local
+	if pool.journal == nil || !pool.journal.active() || !pool.isLocal(from) {
+		return
+	}
+	if err := pool.journal.insert(tx); err != nil {
+		log.Warn("Failed to journal transaction", "err", err)
+	}
 }
 
 // SubscribeTransactions registers a subscription for new transaction events,
@@ -588,10 +698,14 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 		FirstNonceGap:    nil, // Pool allows arbitrary arrival order, don't invalidate nonce gaps
 		UsedAndLeftSlots: nil, // Pool has own mechanism to limit the number of transactions
 		ExistingExpenditure: func(addr common.Address) *big.Int {
+			total := new(uint256.Int)
 			if list := pool.pending[addr]; list != nil {
-				return list.totalcost.ToBig()
+				total.Add(total, list.totalcost)
+			}
+			if list := pool.queue[addr]; list != nil && pool.config.RequireQueuedFunds {
+				total.Add(total, list.totalcost)
 			}
-			return new(big.Int)
+			return total.ToBig()
 		},
 		ExistingCost: func(addr common.Address, nonce uint64) *big.Int {
 			if list := pool.pending[addr]; list != nil {
@@ -599,15 +713,41 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 					return tx.Cost()
 				}
 			}
+			if list := pool.queue[addr]; list != nil && pool.config.RequireQueuedFunds {
+				if tx := list.txs.Get(nonce); tx != nil {
+					return tx.Cost()
+				}
+			}
 			return nil
 		},
 	}
 	if err := txpool.ValidateTransactionWithState(tx, pool.signer, opts); err != nil {
+		if errors.Is(err, core.ErrInsufficientFunds) && pool.config.RequireQueuedFunds {
+			queuedUnfundedMeter.Mark(1)
+		}
+		return err
+	}
+	if err := pool.validateNonceGap(tx); err != nil {
 		return err
 	}
 	return pool.validateAuth(tx)
 }
 
+// validateNonceGap ensures the nonce of the transaction isn't further ahead of
+// its sender's pending nonce than the configured maximum gap.
+func (pool *LegacyPool) validateNonceGap(tx *types.Transaction) error {
+	if pool.config.MaxNonceGap == 0 {
+		return nil
+	}
+	from, _ := types.Sender(pool.signer, tx) // already validated
+	next := pool.pendingNonces.get(from)
+	if tx.Nonce() > next && tx.Nonce()-next > pool.config.MaxNonceGap {
+		queuedNonceGapMeter.Mark(1)
+		return fmt.Errorf("%w: pending nonce %d, tx nonce %d, max gap %d", ErrNonceGapTooLarge, next, tx.Nonce(), pool.config.MaxNonceGap)
+	}
+	return nil
+}
+
 // checkDelegationLimit determines if the tx sender is delegated or has a
 // pending delegation, and if so, ensures they have at most one in-flight
 // **executable** transaction, e.g. disallow stacked and gapped transactions
@@ -792,6 +932,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
+		pool.journalTx(from, tx)
 		pool.queueTxEvent(tx)
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 
@@ -804,6 +945,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 	if err != nil {
 		return false, err
 	}
+	pool.journalTx(from, tx)
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -1532,6 +1674,10 @@ func (pool *LegacyPool) truncateQueue() {
 	if queued <= pool.config.GlobalQueue {
 		return
 	}
+	if pool.config.GapEviction {
+		pool.truncateQueueByGap(queued - pool.config.GlobalQueue)
+		return
+	}
 
 	// Sort all accounts with queued transactions by heartbeat
 	addresses := make(addressesByHeartbeat, 0, len(pool.queue))
@@ -1566,6 +1712,39 @@ func (pool *LegacyPool) truncateQueue() {
 	}
 }
 
+// truncateQueueByGap drops the given number of queued transactions, the ones
+// farthest from their sender's pending nonce first, i.e. the least likely to be
+// executed. On equal gaps, the transactions of the least recently active
+// senders are dropped first.
+func (pool *LegacyPool) truncateQueueByGap(drop uint64) {
+	type gappedTx struct {
+		tx        *types.Transaction
+		gap       uint64
+		heartbeat time.Time
+	}
+	var txs []gappedTx
+	for addr, list := range pool.queue {
+		next := pool.pendingNonces.get(addr)
+		for _, tx := range list.Flatten() {
+			var gap uint64
+			if tx.Nonce() > next {
+				gap = tx.Nonce() - next
+			}
+			txs = append(txs, gappedTx{tx: tx, gap: gap, heartbeat: pool.beats[addr]})
+		}
+	}
+	slices.SortFunc(txs, func(a, b gappedTx) int {
+		if c := cmp.Compare(b.gap, a.gap); c != 0 {
+			return c
+		}
+		return a.heartbeat.Compare(b.heartbeat)
+	})
+	for i := 0; i < len(txs) && uint64(i) < drop; i++ {
+		pool.removeTx(txs[i].tx.Hash(), true, true)
+		queuedRateLimitMeter.Mark(1)
+	}
+}
+
 // demoteUnexecutables removes invalid and processed transactions from the pools
 // executable/pending queue and any subsequent transactions that become unexecutable
 // are moved back into the future queue.
@@ -1659,6 +1838,12 @@ func newAccountSet(signer types.Signer, addrs ...common.Address) *accountSet {
 	return as
 }
 
+// contains checks if a given address is contained within the set.
+func (as *accountSet) contains(addr common.Address) bool {
+	_, exist := as.accounts[addr]
+	return exist
+}
+
 // add inserts a new address into the set to track.
 func (as *accountSet) add(addr common.Address) {
 	as.accounts[addr] = struct{}{}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"path/filepath"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -1032,6 +1033,138 @@ func TestQueueGlobalLimiting(t *testing.T) {
 	}
 }
 
+// Tests that transactions too far ahead of their sender's pending nonce are
+// rejected when the nonce gap is limited.
+func TestQueueNonceGapLimiting(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.MaxNonceGap = 4
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	if err := pool.addRemoteSync(transaction(4, 100000, key)); err != nil {
+		t.Fatalf("failed to add transaction within the nonce gap: %v", err)
+	}
+	if err := pool.addRemoteSync(transaction(5, 100000, key)); !errors.Is(err, ErrNonceGapTooLarge) {
+		t.Fatalf("adding transaction beyond the nonce gap error mismatch: have %v, want %v", err, ErrNonceGapTooLarge)
+	}
+	// The gap is relative to the pending nonce, which moves with the pending transactions
+	if err := pool.addRemoteSync(transaction(0, 100000, key)); err != nil {
+		t.Fatalf("failed to add executable transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(transaction(5, 100000, key)); err != nil {
+		t.Fatalf("failed to add transaction within the moved nonce gap: %v", err)
+	}
+	pending, queued := pool.Stats()
+	if pending != 1 || queued != 2 {
+		t.Fatalf("pool stats mismatch: have %d pending and %d queued, want 1 and 2", pending, queued)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that the balance of a sender must cover its queued transactions when
+// queued funds are required.
+func TestQueueRequireFunds(t *testing.T) {
+	t.Parallel()
+
+	for _, required := range []bool{false, true} {
+		statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+		blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+		config := testTxPoolConfig
+		config.RequireQueuedFunds = required
+
+		pool := New(config, blockchain)
+		pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+		// The balance covers two transactions only
+		key, _ := crypto.GenerateKey()
+		testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(200200))
+
+		for nonce := uint64(1); nonce <= 2; nonce++ {
+			if err := pool.addRemoteSync(transaction(nonce, 100000, key)); err != nil {
+				t.Fatalf("required %v: failed to add queued transaction %d: %v", required, nonce, err)
+			}
+		}
+		err := pool.addRemoteSync(transaction(3, 100000, key))
+		if required && !errors.Is(err, core.ErrInsufficientFunds) {
+			t.Fatalf("required %v: adding unfunded queued transaction error mismatch: have %v, want %v", required, err, core.ErrInsufficientFunds)
+		}
+		if !required && err != nil {
+			t.Fatalf("required %v: failed to add queued transaction: %v", required, err)
+		}
+		// Replacing a queued transaction only needs to cover the price bump
+		if required {
+			if err := pool.addRemoteSync(pricedTransaction(2, 100000, big.NewInt(2), key)); !errors.Is(err, core.ErrInsufficientFunds) {
+				t.Fatalf("replacing queued transaction error mismatch: have %v, want %v", err, core.ErrInsufficientFunds)
+			}
+			testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(100000))
+			if err := pool.addRemoteSync(pricedTransaction(2, 100000, big.NewInt(2), key)); err != nil {
+				t.Fatalf("failed to replace funded queued transaction: %v", err)
+			}
+		}
+		if err := validatePoolInternals(pool); err != nil {
+			t.Fatalf("required %v: pool internal state corrupted: %v", required, err)
+		}
+		pool.Close()
+	}
+}
+
+// Tests that the queued transactions farthest from their sender's pending nonce
+// are evicted first with the gap eviction.
+func TestQueueGapEviction(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.GlobalQueue = 4
+	config.GapEviction = true
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	near, _ := crypto.GenerateKey()
+	far, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(near.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(far.PublicKey), big.NewInt(1000000000))
+
+	// The recently active sender with the farthest nonces loses its transactions
+	pool.addRemotesSync([]*types.Transaction{
+		transaction(1, 100000, near), transaction(2, 100000, near), transaction(3, 100000, near),
+	})
+	pool.addRemotesSync([]*types.Transaction{
+		transaction(10, 100000, far), transaction(11, 100000, far), transaction(12, 100000, far),
+	})
+
+	pending, queued := pool.Stats()
+	if pending != 0 || queued != 4 {
+		t.Fatalf("pool stats mismatch: have %d pending and %d queued, want 0 and 4", pending, queued)
+	}
+	if have := pool.queue[crypto.PubkeyToAddress(near.PublicKey)].Len(); have != 3 {
+		t.Fatalf("near sender queued transactions mismatch: have %d, want 3", have)
+	}
+	if have := pool.queue[crypto.PubkeyToAddress(far.PublicKey)].Flatten(); len(have) != 1 || have[0].Nonce() != 10 {
+		t.Fatalf("far sender queued transactions mismatch: have %d", len(have))
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that if an account remains idle for a prolonged amount of time, any
 // non-executable transactions queued up are dropped to prevent wasting resources
 // on shuffling them around.
@@ -2181,6 +2314,69 @@ func TestReplacementDynamicFee(t *testing.T) {
 	}
 }
 
+// Tests that the transactions of the journaled accounts are stored to disk,
+// and that they are replayed into the pool on the first reset after a restart.
+func TestJournaling(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	// Create the pool with a journal of the local account transactions
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	local, _ := crypto.GenerateKey()
+	remote, _ := crypto.GenerateKey()
+
+	config := testTxPoolConfig
+	config.Journal = journal
+	config.Locals = []common.Address{crypto.PubkeyToAddress(local.PublicKey)}
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+	testAddBalance(pool, crypto.PubkeyToAddress(local.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(remote.PublicKey), big.NewInt(1000000000))
+
+	// The transactions added before the journal is loaded are journaled by the rotation
+	if err := pool.addRemotesSync([]*types.Transaction{
+		pricedTransaction(0, 100000, big.NewInt(1), local),
+		pricedTransaction(2, 100000, big.NewInt(1), local),
+		pricedTransaction(0, 100000, big.NewInt(1), remote),
+	}); err[0] != nil || err[1] != nil || err[2] != nil {
+		t.Fatalf("failed to add transactions: %v", err)
+	}
+	pool.Reset(nil, nil)
+
+	// The transactions added after the journal is loaded are appended to it
+	if err := pool.addRemoteSync(pricedTransaction(1, 100000, big.NewInt(1), local)); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	pool.Close()
+
+	// Restart the pool, the local transactions are replayed on the first reset
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("transactions loaded before the first reset: pending %d, queued %d", pending, queued)
+	}
+	pool.Reset(nil, nil)
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, config.Locals...))
+
+	pending, queued := pool.Stats()
+	if pending != 3 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 3)
+	}
+	if queued != 0 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 0)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // TestStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestStatusCheck(t *testing.T) {
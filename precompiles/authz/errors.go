@@ -0,0 +1,12 @@
+package authz
+
+const (
+	// ErrDisallowedMsgType is raised when a message type cannot be granted or executed.
+	ErrDisallowedMsgType = "msg type %s is not allowed in the authz precompile"
+	// ErrNestedAuthzMsg is raised when an executed message is an authz message.
+	ErrNestedAuthzMsg = "nested authz msg %s is not allowed"
+	// ErrInvalidExpiration is raised when the expiration of a grant is negative.
+	ErrInvalidExpiration = "invalid expiration %d"
+	// ErrInvalidMsgs is raised when the executed messages are not valid.
+	ErrInvalidMsgs = "invalid msgs: %s"
+)
@@ -0,0 +1,117 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeAuthorizationGranted defines the event type for the authz Grant transactions.
+	EventTypeAuthorizationGranted = "AuthorizationGranted"
+	// EventTypeAuthorizationRevoked defines the event type for the authz Revoke transaction.
+	EventTypeAuthorizationRevoked = "AuthorizationRevoked"
+	// EventTypeAuthorizationExecuted defines the event type for the authz Exec transaction.
+	EventTypeAuthorizationExecuted = "AuthorizationExecuted"
+)
+
+// EventAuthorization is the event emitted when an authorization is granted or revoked
+type EventAuthorization struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventAuthorizationExecuted is the event emitted when messages are executed by a grantee
+type EventAuthorizationExecuted struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive
+}
+
+// EmitAuthorizationGrantedEvent emits the AuthorizationGranted event
+func (p Precompile) EmitAuthorizationGrantedEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationGranted, granter, grantee, msgTypeURL)
+}
+
+// EmitAuthorizationRevokedEvent emits the AuthorizationRevoked event
+func (p Precompile) EmitAuthorizationRevokedEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationRevoked, granter, grantee, msgTypeURL)
+}
+
+// EmitAuthorizationExecutedEvent emits the AuthorizationExecuted event
+func (p Precompile) EmitAuthorizationExecutedEvent(ctx sdk.Context, stateDB vm.StateDB, grantee common.Address, msgTypeURLs []string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeAuthorizationExecuted]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	data, err := event.Inputs.NonIndexed().Pack(msgTypeURLs)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// emitAuthorizationEvent emits an event with the granter and grantee as topics
+// and the message type URL as data.
+func (p Precompile) emitAuthorizationEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+	msgTypeURL string,
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	data, err := event.Inputs.NonIndexed().Pack(msgTypeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
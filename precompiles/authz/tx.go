@@ -0,0 +1,170 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz generic
+	// authorization Grant transaction.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz send
+	// authorization Grant transaction.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization for a message type from the caller to
+// the grantee.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, method, stateDB, contract, msg, granter, grantee)
+}
+
+// GrantSend grants a send authorization from the caller to the grantee.
+func (p Precompile) GrantSend(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrantSend(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, method, stateDB, contract, msg, granter, grantee)
+}
+
+// Revoke revokes an authorization given by the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err := p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationRevokedEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes messages on behalf of the accounts that granted the caller an
+// authorization for them.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, msgTypeURLs, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != grantee {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), grantee.String())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range msgs {
+		// authz messages are rejected so that the disallowed message types
+		// cannot be granted or executed through nested messages
+		switch m.(type) {
+		case *authztypes.MsgExec, *authztypes.MsgGrant:
+			return nil, fmt.Errorf(ErrNestedAuthzMsg, msgTypeURLs[i])
+		}
+		if err := p.checkMsgType(msgTypeURLs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := p.authzMsgServer.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationExecutedEvent(ctx, stateDB, grantee, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant checks that the caller is the granter and saves the grant.
+func (p Precompile) grant(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	msg *authztypes.MsgGrant,
+	granter, grantee common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+	msgTypeURL := authorization.MsgTypeURL()
+	if err := p.checkMsgType(msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationGrantedEvent(ctx, stateDB, granter, grantee, msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
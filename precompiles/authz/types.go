@@ -0,0 +1,451 @@
+package authz
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Grant represents an authorization given by a granter to a grantee
+type Grant struct {
+	Granter           common.Address   `abi:"granter"`
+	Grantee           common.Address   `abi:"grantee"`
+	AuthorizationType string           `abi:"authorizationType"`
+	MsgTypeUrl        string           `abi:"msgTypeUrl"` //nolint:revive
+	SpendLimit        []cmn.Coin       `abi:"spendLimit"`
+	AllowList         []common.Address `abi:"allowList"`
+	Expiration        int64            `abi:"expiration"`
+}
+
+// GrantSendInput represents the input of the send authorization grant
+type GrantSendInput struct {
+	Granter    common.Address   `abi:"granter"`
+	Grantee    common.Address   `abi:"grantee"`
+	SpendLimit []cmn.Coin       `abi:"spendLimit"`
+	AllowList  []common.Address `abi:"allowList"`
+	Expiration int64            `abi:"expiration"`
+}
+
+// GrantsInput represents the input of the grants query
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgTypeUrl string            `abi:"msgTypeUrl"` //nolint:revive
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput represents the input of the granter grants query
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranteeGrantsInput represents the input of the grantee grants query
+type GranteeGrantsInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GrantsOutput represents the output of the grants queries
+type GrantsOutput struct {
+	Grants       []Grant            `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrant creates a new MsgGrant instance with a generic authorization from
+// the given arguments. It also returns the granter and grantee addresses.
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "msgTypeUrl", "", args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "expiration", int64(0), args[3])
+	}
+
+	msg, err := newMsgGrant(addrCdc, granter, grantee, authztypes.NewGenericAuthorization(msgTypeURL), expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant instance with a send authorization from
+// the given arguments. It also returns the granter and grantee addresses.
+func NewMsgGrantSend(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput: %s", err)
+	}
+
+	granter, grantee, err := parseAddresses(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(input.SpendLimit)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		allowList[i] = addr.Bytes()
+	}
+
+	msg, err := newMsgGrant(addrCdc, granter, grantee, banktypes.NewSendAuthorization(spendLimit, allowList), input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance from the given arguments.
+// It also returns the granter and grantee addresses.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authztypes.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "msgTypeUrl", "", args[2])
+	}
+
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authztypes.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the given arguments, the
+// messages are decoded from their JSON encoding. It also returns the grantee
+// address and the type URLs of the messages.
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authztypes.MsgExec, common.Address, []string, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, "no msgs")
+	}
+
+	anys := make([]*codectypes.Any, len(jsonMsgs))
+	msgTypeURLs := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(json.RawMessage(jsonMsg), &msg); err != nil {
+			return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidMsgs, fmt.Sprintf("msg %d: %s", i, err))
+		}
+
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, nil, err
+		}
+		anys[i] = anyMsg
+		msgTypeURLs[i] = anyMsg.TypeUrl
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+
+	msg := &authztypes.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anys,
+	}
+
+	return msg, grantee, msgTypeURLs, nil
+}
+
+// ParseGrantsArgs parses the arguments of the grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granter, grantee, err := bech32Addresses(addrCdc, input.Granter, input.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments of the granter grants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert granter address: %w", err)
+	}
+
+	return &authztypes.QueryGranterGrantsRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments of the grantee grants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+
+	return &authztypes.QueryGranteeGrantsRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the GrantsOutput from the response of the grants query.
+func (o *GrantsOutput) FromGrants(
+	req *authztypes.QueryGrantsRequest,
+	res *authztypes.QueryGrantsResponse,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	granter, err := addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert granter address: %w", err)
+	}
+	grantee, err := addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+
+	o.Grants = make([]Grant, len(res.Grants))
+	for i, grant := range res.Grants {
+		o.Grants[i], err = NewGrant(
+			common.BytesToAddress(granter),
+			common.BytesToAddress(grantee),
+			grant.Authorization,
+			grant.Expiration,
+			addrCdc,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if res.Pagination != nil {
+		o.PageResponse = *res.Pagination
+	}
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants of a
+// granter or of a grantee.
+func (o *GrantsOutput) FromGrantAuthorizations(
+	grants []*authztypes.GrantAuthorization,
+	pageRes *query.PageResponse,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert granter address: %w", err)
+		}
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert grantee address: %w", err)
+		}
+
+		o.Grants[i], err = NewGrant(
+			common.BytesToAddress(granter),
+			common.BytesToAddress(grantee),
+			grant.Authorization,
+			grant.Expiration,
+			addrCdc,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pageRes != nil {
+		o.PageResponse = *pageRes
+	}
+	return o, nil
+}
+
+// NewGrant creates a new Grant from an authorization. The spend limit and the
+// allow list are only set for send authorizations.
+func NewGrant(
+	granter, grantee common.Address,
+	authorizationAny *codectypes.Any,
+	expiration *time.Time,
+	addrCdc address.Codec,
+) (Grant, error) {
+	if authorizationAny == nil {
+		return Grant{}, fmt.Errorf("empty authorization")
+	}
+	authorization, ok := authorizationAny.GetCachedValue().(authztypes.Authorization)
+	if !ok {
+		return Grant{}, fmt.Errorf("invalid authorization type %s", authorizationAny.TypeUrl)
+	}
+
+	grant := Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+
+	if send, ok := authorization.(*banktypes.SendAuthorization); ok {
+		grant.SpendLimit = cmn.NewCoinsResponse(send.SpendLimit)
+		for _, allowed := range send.AllowList {
+			addr, err := addrCdc.StringToBytes(allowed)
+			if err != nil {
+				return Grant{}, fmt.Errorf("failed to convert allowed address: %w", err)
+			}
+			grant.AllowList = append(grant.AllowList, common.BytesToAddress(addr))
+		}
+	}
+
+	if expiration != nil {
+		grant.Expiration = expiration.Unix()
+	}
+
+	return grant, nil
+}
+
+// newMsgGrant creates a MsgGrant of the given authorization, a zero expiration
+// means that the grant does not expire.
+func newMsgGrant(
+	addrCdc address.Codec,
+	granter, grantee common.Address,
+	authorization authztypes.Authorization,
+	expiration int64,
+) (*authztypes.MsgGrant, error) {
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &authztypes.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		msg.Grant.Expiration = &expirationTime
+	}
+	if err := msg.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// parseAddresses parses the granter and grantee address arguments.
+func parseAddresses(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// bech32Addresses converts the granter and grantee addresses to their bech32
+// representation.
+func bech32Addresses(addrCdc address.Codec, granter, grantee common.Address) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert granter address: %w", err)
+	}
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+	return granterAddr, granteeAddr, nil
+}
@@ -0,0 +1,166 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/encoding"
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	granter = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee = common.HexToAddress("0x0987654321098765432109876543210987654321")
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		expExpiration *time.Time
+		errMsg        string
+	}{
+		{
+			name: "no expiration",
+			args: []interface{}{granter, grantee, msgTypeURL, int64(0)},
+		},
+		{
+			name:          "expiration",
+			args:          []interface{}{granter, grantee, msgTypeURL, int64(100)},
+			expExpiration: func() *time.Time { t := time.Unix(100, 0).UTC(); return &t }(),
+		},
+		{
+			name:   "invalid number of arguments",
+			args:   []interface{}{granter, grantee},
+			errMsg: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 2),
+		},
+		{
+			name:   "empty granter",
+			args:   []interface{}{common.Address{}, grantee, msgTypeURL, int64(0)},
+			errMsg: "invalid hex address",
+		},
+		{
+			name:   "empty msg type",
+			args:   []interface{}{granter, grantee, "", int64(0)},
+			errMsg: "invalid type for msgTypeUrl",
+		},
+		{
+			name:   "negative expiration",
+			args:   []interface{}{granter, grantee, msgTypeURL, int64(-1)},
+			errMsg: fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotGranter, gotGrantee, err := NewMsgGrant(tt.args, addrCdc)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, gotGranter)
+			require.Equal(t, grantee, gotGrantee)
+			require.Equal(t, tt.expExpiration, msg.Grant.Expiration)
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, authztypes.NewGenericAuthorization(msgTypeURL), authorization)
+		})
+	}
+}
+
+func TestNewMsgGrantSend(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	method := ABI.Methods[GrantSendMethod]
+	allowed := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	msg, _, _, err := NewMsgGrantSend(&method, []interface{}{
+		granter, grantee, []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(10)}}, []common.Address{allowed}, int64(0),
+	}, addrCdc)
+	require.NoError(t, err)
+	authorization, err := msg.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, banktypes.NewSendAuthorization(
+		sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(10))),
+		[]sdk.AccAddress{allowed.Bytes()},
+	), authorization)
+
+	_, _, _, err = NewMsgGrantSend(&method, []interface{}{
+		granter, grantee, []cmn.Coin{{Denom: "", Amount: big.NewInt(10)}}, []common.Address{}, int64(0),
+	}, addrCdc)
+	require.ErrorContains(t, err, "invalid spend limit")
+}
+
+func TestNewMsgExec(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(config.DefaultEVMChainID)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Codec
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	send := banktypes.NewMsgSend(granter.Bytes(), grantee.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("aaa", 1)))
+	sendJSON, err := cdc.MarshalInterfaceJSON(send)
+	require.NoError(t, err)
+
+	msg, gotGrantee, msgTypeURLs, err := NewMsgExec([]interface{}{grantee, [][]byte{sendJSON}}, cdc, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, grantee, gotGrantee)
+	require.Equal(t, []string{sdk.MsgTypeURL(send)}, msgTypeURLs)
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	_, _, _, err = NewMsgExec([]interface{}{grantee, [][]byte{}}, cdc, addrCdc)
+	require.ErrorContains(t, err, "no msgs")
+
+	_, _, _, err = NewMsgExec([]interface{}{grantee, [][]byte{[]byte("{}")}}, cdc, addrCdc)
+	require.ErrorContains(t, err, "msg 0")
+}
+
+func TestNewGrant(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	allowed := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	expiration := time.Unix(100, 0)
+
+	sendAuthz := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("aaa", 10)), []sdk.AccAddress{allowed.Bytes()})
+	authorizationAny, err := codectypes.NewAnyWithValue(sendAuthz)
+	require.NoError(t, err)
+
+	grant, err := NewGrant(granter, grantee, authorizationAny, &expiration, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: sdk.MsgTypeURL(sendAuthz),
+		MsgTypeUrl:        sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		SpendLimit:        []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(10)}},
+		AllowList:         []common.Address{allowed},
+		Expiration:        100,
+	}, grant)
+
+	// the output is packed as a grant of the queries
+	method := ABI.Methods[GrantsMethod]
+	_, err = method.Outputs.Pack([]Grant{grant}, (&GrantsOutput{}).PageResponse)
+	require.NoError(t, err)
+
+	_, err = NewGrant(granter, grantee, nil, nil, addrCdc)
+	require.ErrorContains(t, err, "empty authorization")
+}
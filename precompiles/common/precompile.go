@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -96,6 +97,9 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 	// we need to consume the gas that was already used by the EVM
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
+	// the bank movements of the precompile are reported as logs of the EVM transaction
+	ctx = evmtypes.WithPrecompileLogs(ctx, stateDB.AddLog)
+
 	var balanceHandler *BalanceHandler
 	if p.BalanceHandlerFactory != nil {
 		balanceHandler = p.BalanceHandlerFactory.NewBalanceHandler()
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance defines a fee allowance given by a granter to a grantee.
+struct Allowance {
+    /// @dev Address of the account paying the fees
+    address granter;
+    /// @dev Address of the account whose fees are paid
+    address grantee;
+    /// @dev Type URL of the allowance, e.g. /cosmos.feegrant.v1beta1.BasicAllowance
+    string allowanceType;
+    /// @dev Remaining coins the grantee can spend in fees, empty for no limit
+    Coin[] spendLimit;
+    /// @dev Unix time at which the allowance expires, zero if it does not expire
+    int64 expiration;
+    /// @dev Duration of a period in seconds, only set for periodic allowances
+    int64 period;
+    /// @dev Coins the grantee can spend in fees in each period, only set for periodic allowances
+    Coin[] periodSpendLimit;
+    /// @dev Coins the grantee can still spend in the current period, only set for periodic allowances
+    Coin[] periodCanSpend;
+    /// @dev Unix time at which the current period ends, only set for periodic allowances
+    int64 periodReset;
+    /// @dev Type URLs of the messages the allowance can pay fees for, empty for any message
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the feegrant module
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @param allowanceType The type URL of the granted allowance
+    event AllowanceGranted(
+        address indexed granter,
+        address indexed grantee,
+        string allowanceType
+    );
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter The address of the account that was paying the fees
+    /// @param grantee The address of the account whose fees were paid
+    event AllowanceRevoked(address indexed granter, address indexed grantee);
+
+    /// @dev Grants the grantee a basic allowance to pay its transaction fees
+    /// with the granter's coins.
+    /// @param granter The address of the account paying the fees, must be the caller
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The coins the grantee can spend in fees, empty for no limit
+    /// @param expiration The unix time at which the allowance expires, zero for no expiration
+    /// @return success Whether the allowance was granted
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants the grantee a periodic allowance to pay its transaction fees
+    /// with the granter's coins, up to a limit that is reset every period.
+    /// @param granter The address of the account paying the fees, must be the caller
+    /// @param grantee The address of the account whose fees are paid
+    /// @param spendLimit The coins the grantee can spend in fees in total, empty for no limit
+    /// @param expiration The unix time at which the allowance expires, zero for no expiration
+    /// @param period The duration of a period in seconds
+    /// @param periodSpendLimit The coins the grantee can spend in fees in each period
+    /// @return success Whether the allowance was granted
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance of the grantee.
+    /// @param granter The address of the account paying the fees, must be the caller
+    /// @param grantee The address of the account whose fees are paid
+    /// @return success Whether the allowance was revoked
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Returns the fee allowance of a granter to a grantee.
+    /// @param granter The address of the account paying the fees
+    /// @param grantee The address of the account whose fees are paid
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Returns the fee allowances received by a grantee.
+    /// @param grantee The address of the account whose fees are paid
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances received by the grantee
+    /// @return pageResponse The pagination response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            Allowance[] memory allowances,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the fee allowances given by a granter.
+    /// @param granter The address of the account paying the fees
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances given by the granter
+    /// @return pageResponse The pagination response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            Allowance[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
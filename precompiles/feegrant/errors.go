@@ -0,0 +1,8 @@
+package feegrant
+
+const (
+	// ErrInvalidExpiration is raised when the expiration of an allowance is negative.
+	ErrInvalidExpiration = "invalid expiration %d"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not positive.
+	ErrInvalidPeriod = "invalid period %d"
+)
@@ -0,0 +1,84 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeAllowanceGranted defines the event type for the feegrant GrantAllowance transactions.
+	EventTypeAllowanceGranted = "AllowanceGranted"
+	// EventTypeAllowanceRevoked defines the event type for the feegrant RevokeAllowance transaction.
+	EventTypeAllowanceRevoked = "AllowanceRevoked"
+)
+
+// EventAllowanceGranted is the event emitted when a fee allowance is granted
+type EventAllowanceGranted struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventAllowanceRevoked is the event emitted when a fee allowance is revoked
+type EventAllowanceRevoked struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// EmitAllowanceGrantedEvent emits the AllowanceGranted event
+func (p Precompile) EmitAllowanceGrantedEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, allowanceType string) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeAllowanceGranted, granter, grantee, allowanceType)
+}
+
+// EmitAllowanceRevokedEvent emits the AllowanceRevoked event
+func (p Precompile) EmitAllowanceRevokedEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address) error {
+	return p.emitAllowanceEvent(ctx, stateDB, EventTypeAllowanceRevoked, granter, grantee)
+}
+
+// emitAllowanceEvent emits an event with the granter and grantee as topics
+// and the remaining event arguments as data.
+func (p Precompile) emitAllowanceEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+	data ...interface{},
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
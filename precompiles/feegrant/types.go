@@ -0,0 +1,403 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Allowance represents a fee allowance given by a granter to a grantee
+type Allowance struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	AllowanceType    string         `abi:"allowanceType"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+	AllowedMessages  []string       `abi:"allowedMessages"`
+}
+
+// GrantAllowanceInput represents the input of the basic allowance grant
+type GrantAllowanceInput struct {
+	Granter    common.Address `abi:"granter"`
+	Grantee    common.Address `abi:"grantee"`
+	SpendLimit []cmn.Coin     `abi:"spendLimit"`
+	Expiration int64          `abi:"expiration"`
+}
+
+// GrantPeriodicAllowanceInput represents the input of the periodic allowance grant
+type GrantPeriodicAllowanceInput struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+}
+
+// AllowancesInput represents the input of the allowances query
+type AllowancesInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesByGranterInput represents the input of the allowances by granter query
+type AllowancesByGranterInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesOutput represents the output of the allowances queries
+type AllowancesOutput struct {
+	Allowances   []Allowance        `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance with a basic
+// allowance from the given arguments. It also returns the granter and grantee
+// addresses.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput: %s", err)
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return newMsgGrantAllowance(addrCdc, input.Granter, input.Grantee, basic)
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance instance with a
+// periodic allowance from the given arguments. The first period starts at the
+// given block time. It also returns the granter and grantee addresses.
+func NewMsgGrantPeriodicAllowance(
+	method *abi.Method,
+	args []interface{},
+	blockTime time.Time,
+	addrCdc address.Codec,
+) (*feegranttypes.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input GrantPeriodicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to GrantPeriodicAllowanceInput: %s", err)
+	}
+
+	basic, err := newBasicAllowance(input.SpendLimit, input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	if input.Period <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+	period := time.Duration(input.Period) * time.Second
+
+	periodSpendLimit, err := newSpendLimit(input.PeriodSpendLimit)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid period spend limit: %w", err)
+	}
+
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period),
+	}
+
+	return newMsgGrantAllowance(addrCdc, input.Granter, input.Grantee, periodic)
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance from the
+// given arguments. It also returns the granter and grantee addresses.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegranttypes.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments of the allowance query.
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegranttypes.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments of the allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+
+	return &feegranttypes.QueryAllowancesRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments of the allowances by granter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert granter address: %w", err)
+	}
+
+	return &feegranttypes.QueryAllowancesByGranterRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the AllowancesOutput from the fee allowance grants of a
+// granter or of a grantee.
+func (o *AllowancesOutput) FromGrants(
+	grants []*feegranttypes.Grant,
+	pageRes *query.PageResponse,
+	addrCdc address.Codec,
+) (*AllowancesOutput, error) {
+	o.Allowances = make([]Allowance, len(grants))
+	for i, grant := range grants {
+		var err error
+		o.Allowances[i], err = NewAllowance(grant, addrCdc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pageRes != nil {
+		o.PageResponse = *pageRes
+	}
+	return o, nil
+}
+
+// NewAllowance creates a new Allowance from a fee allowance grant. The period
+// fields are only set for periodic allowances and the allowed messages only
+// for allowed message allowances, whose wrapped allowance is unpacked.
+func NewAllowance(grant *feegranttypes.Grant, addrCdc address.Codec) (Allowance, error) {
+	if grant == nil || grant.Allowance == nil {
+		return Allowance{}, fmt.Errorf("empty allowance")
+	}
+
+	granter, err := addrCdc.StringToBytes(grant.Granter)
+	if err != nil {
+		return Allowance{}, fmt.Errorf("failed to convert granter address: %w", err)
+	}
+	grantee, err := addrCdc.StringToBytes(grant.Grantee)
+	if err != nil {
+		return Allowance{}, fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+
+	feeAllowance, ok := grant.Allowance.GetCachedValue().(feegranttypes.FeeAllowanceI)
+	if !ok {
+		return Allowance{}, fmt.Errorf("invalid allowance type %s", grant.Allowance.TypeUrl)
+	}
+
+	allowance := Allowance{
+		Granter:          common.BytesToAddress(granter),
+		Grantee:          common.BytesToAddress(grantee),
+		AllowanceType:    grant.Allowance.TypeUrl,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	if allowedMsg, ok := feeAllowance.(*feegranttypes.AllowedMsgAllowance); ok {
+		allowance.AllowedMessages = allowedMsg.AllowedMessages
+		feeAllowance, err = allowedMsg.GetAllowance()
+		if err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	switch a := feeAllowance.(type) {
+	case *feegranttypes.BasicAllowance:
+		allowance.setBasic(a)
+	case *feegranttypes.PeriodicAllowance:
+		allowance.setBasic(&a.Basic)
+		allowance.Period = int64(a.Period / time.Second)
+		allowance.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		allowance.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		allowance.PeriodReset = a.PeriodReset.Unix()
+	}
+
+	return allowance, nil
+}
+
+// setBasic sets the spend limit and the expiration of a basic allowance.
+func (a *Allowance) setBasic(basic *feegranttypes.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// newBasicAllowance creates a BasicAllowance, an empty spend limit means that
+// there is no limit and a zero expiration that the allowance does not expire.
+func newBasicAllowance(spendLimitArg []cmn.Coin, expiration int64) (*feegranttypes.BasicAllowance, error) {
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	spendLimit, err := newSpendLimit(spendLimitArg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: spendLimit,
+	}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// newSpendLimit converts the coins of a spend limit, an empty spend limit is
+// returned as nil since the feegrant module rejects empty non-nil limits.
+func newSpendLimit(coins []cmn.Coin) (sdk.Coins, error) {
+	if len(coins) == 0 {
+		return nil, nil
+	}
+	return cmn.NewSdkCoinsFromCoins(coins)
+}
+
+// newMsgGrantAllowance creates a MsgGrantAllowance of the given allowance.
+func newMsgGrantAllowance(
+	addrCdc address.Codec,
+	granterArg, granteeArg common.Address,
+	allowance feegranttypes.FeeAllowanceI,
+) (*feegranttypes.MsgGrantAllowance, common.Address, common.Address, error) {
+	granter, grantee, err := parseAddresses(granterArg, granteeArg)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	allowanceMsg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("cannot proto marshal %T", allowance)
+	}
+	allowanceAny, err := codectypes.NewAnyWithValue(allowanceMsg)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &feegranttypes.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// parseAddresses parses the granter and grantee address arguments.
+func parseAddresses(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// bech32Addresses converts the granter and grantee addresses to their bech32
+// representation.
+func bech32Addresses(addrCdc address.Codec, granter, grantee common.Address) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert granter address: %w", err)
+	}
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert grantee address: %w", err)
+	}
+	return granterAddr, granteeAddr, nil
+}
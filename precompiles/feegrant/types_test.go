@@ -0,0 +1,189 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	granter = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee = common.HexToAddress("0x0987654321098765432109876543210987654321")
+)
+
+func TestNewMsgGrantAllowance(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	method := ABI.Methods[GrantAllowanceMethod]
+	spendLimit := []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(10)}}
+
+	tests := []struct {
+		name         string
+		args         []interface{}
+		expAllowance *feegranttypes.BasicAllowance
+		errMsg       string
+	}{
+		{
+			name:         "no spend limit and no expiration",
+			args:         []interface{}{granter, grantee, []cmn.Coin{}, int64(0)},
+			expAllowance: &feegranttypes.BasicAllowance{},
+		},
+		{
+			name: "spend limit and expiration",
+			args: []interface{}{granter, grantee, spendLimit, int64(100)},
+			expAllowance: &feegranttypes.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(10))),
+				Expiration: func() *time.Time { t := time.Unix(100, 0).UTC(); return &t }(),
+			},
+		},
+		{
+			name:   "invalid number of arguments",
+			args:   []interface{}{granter, grantee},
+			errMsg: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 2),
+		},
+		{
+			name:   "empty grantee",
+			args:   []interface{}{granter, common.Address{}, spendLimit, int64(0)},
+			errMsg: "invalid hex address",
+		},
+		{
+			name:   "invalid spend limit",
+			args:   []interface{}{granter, grantee, []cmn.Coin{{Denom: "", Amount: big.NewInt(10)}}, int64(0)},
+			errMsg: "invalid spend limit",
+		},
+		{
+			name:   "negative expiration",
+			args:   []interface{}{granter, grantee, spendLimit, int64(-1)},
+			errMsg: fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotGranter, gotGrantee, err := NewMsgGrantAllowance(&method, tt.args, addrCdc)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, gotGranter)
+			require.Equal(t, grantee, gotGrantee)
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tt.expAllowance, allowance)
+		})
+	}
+}
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	method := ABI.Methods[GrantPeriodicAllowanceMethod]
+	blockTime := time.Unix(1_000, 0).UTC()
+	periodSpendLimit := []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(5)}}
+
+	msg, _, _, err := NewMsgGrantPeriodicAllowance(&method, []interface{}{
+		granter, grantee, []cmn.Coin{}, int64(0), int64(60), periodSpendLimit,
+	}, blockTime, addrCdc)
+	require.NoError(t, err)
+	allowance, err := msg.GetFeeAllowanceI()
+	require.NoError(t, err)
+	require.Equal(t, &feegranttypes.PeriodicAllowance{
+		Period:           time.Minute,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(5))),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(5))),
+		PeriodReset:      blockTime.Add(time.Minute),
+	}, allowance)
+
+	_, _, _, err = NewMsgGrantPeriodicAllowance(&method, []interface{}{
+		granter, grantee, []cmn.Coin{}, int64(0), int64(0), periodSpendLimit,
+	}, blockTime, addrCdc)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidPeriod, 0))
+
+	_, _, _, err = NewMsgGrantPeriodicAllowance(&method, []interface{}{
+		granter, grantee, []cmn.Coin{}, int64(0), int64(60), []cmn.Coin{{Denom: "", Amount: big.NewInt(5)}},
+	}, blockTime, addrCdc)
+	require.ErrorContains(t, err, "invalid period spend limit")
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	msg, gotGranter, gotGrantee, err := NewMsgRevokeAllowance([]interface{}{granter, grantee}, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, granter, gotGranter)
+	require.Equal(t, grantee, gotGrantee)
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, granterAddr, msg.Granter)
+
+	_, _, _, err = NewMsgRevokeAllowance([]interface{}{granter}, addrCdc)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+
+	_, _, _, err = NewMsgRevokeAllowance([]interface{}{granter, "grantee"}, addrCdc)
+	require.ErrorContains(t, err, "invalid hex address")
+}
+
+func TestNewAllowance(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	granterAddr, granteeAddr, err := bech32Addresses(addrCdc, granter, grantee)
+	require.NoError(t, err)
+
+	expiration := time.Unix(100, 0)
+	periodReset := time.Unix(200, 0)
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic: feegranttypes.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aaa", 10)),
+			Expiration: &expiration,
+		},
+		Period:           time.Minute,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aaa", 5)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("aaa", 3)),
+		PeriodReset:      periodReset,
+	}
+	allowedMsgTypes := []string{"/cosmos.evm.vm.v1.MsgEthereumTx"}
+	allowedMsg, err := feegranttypes.NewAllowedMsgAllowance(periodic, allowedMsgTypes)
+	require.NoError(t, err)
+	allowanceAny, err := codectypes.NewAnyWithValue(allowedMsg)
+	require.NoError(t, err)
+
+	allowance, err := NewAllowance(&feegranttypes.Grant{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, Allowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		AllowanceType:    sdk.MsgTypeURL(allowedMsg),
+		SpendLimit:       []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(10)}},
+		Expiration:       100,
+		Period:           60,
+		PeriodSpendLimit: []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(5)}},
+		PeriodCanSpend:   []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(3)}},
+		PeriodReset:      200,
+		AllowedMessages:  allowedMsgTypes,
+	}, allowance)
+
+	// the output is packed as an allowance of the queries
+	method := ABI.Methods[AllowancesMethod]
+	_, err = method.Outputs.Pack([]Allowance{allowance}, (&AllowancesOutput{}).PageResponse)
+	require.NoError(t, err)
+
+	_, err = NewAllowance(&feegranttypes.Grant{Granter: granterAddr, Grantee: granteeAddr}, addrCdc)
+	require.ErrorContains(t, err, "empty allowance")
+}
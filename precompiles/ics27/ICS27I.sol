@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ICS27I contract's address.
+address constant ICS27_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The ICS27 contract's instance.
+ICS27I constant ICS27_CONTRACT = ICS27I(ICS27_PRECOMPILE_ADDRESS);
+
+/// @dev CosmosMsg defines a protobuf encoded Cosmos message executed by an
+/// interchain account on the host chain.
+struct CosmosMsg {
+    /// type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    string typeUrl;
+    /// protobuf encoded message
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title ICS27 Interchain Accounts Controller Precompiled Contract
+/// @dev The interface through which solidity contracts will control interchain accounts (ICS27)
+/// on remote chains. The acknowledgement or timeout of the packets sent by a contract
+/// are delivered to the contract through the ICallbacks interface.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface ICS27I {
+    /// @dev Emitted when the registration of an interchain account is initiated.
+    /// @param owner The address of the interchain account owner.
+    /// @param connectionId The connection identifier to the host chain.
+    /// @param portId The controller port of the interchain account.
+    /// @param channelId The channel being opened for the interchain account.
+    event InterchainAccountRegistered(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev Emitted when an interchain account transaction is sent.
+    /// @param owner The address of the interchain account owner.
+    /// @param connectionId The connection identifier to the host chain.
+    /// @param sequence The sequence number of the packet sent.
+    event InterchainTxSent(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence
+    );
+
+    /// @dev RegisterInterchainAccount initiates the opening of an interchain account
+    /// channel on the given connection. The account is created on the host chain
+    /// once the channel handshake is completed by a relayer.
+    /// @param owner the hex address of the interchain account owner, must be the caller
+    /// @param connectionId the connection identifier to the host chain
+    /// @param version the ICS27 channel version, the default metadata is used when empty
+    /// @return portId the controller port of the interchain account
+    /// @return channelId the channel being opened for the interchain account
+    function registerInterchainAccount(
+        address owner,
+        string memory connectionId,
+        string memory version
+    ) external returns (string memory portId, string memory channelId);
+
+    /// @dev SendTx sends an interchain account packet executing the messages on the
+    /// host chain. When the owner is a contract, a source callback to the owner is
+    /// added to the memo unless the memo already defines one.
+    /// @param owner the hex address of the interchain account owner, must be the caller
+    /// @param connectionId the connection identifier to the host chain
+    /// @param msgs the protobuf encoded messages to execute
+    /// @param memo optional JSON memo of the packet
+    /// @param relativeTimeout the timeout in nanoseconds relative to the current block time
+    /// @return sequence sequence number of the packet sent
+    function sendTx(
+        address owner,
+        string memory connectionId,
+        CosmosMsg[] memory msgs,
+        string memory memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev InterchainAccount returns the address of the interchain account of an owner
+    /// on the host chain.
+    /// @param owner the hex address of the interchain account owner
+    /// @param connectionId the connection identifier to the host chain
+    /// @return accountAddress the address of the account on the host chain
+    function interchainAccount(
+        address owner,
+        string memory connectionId
+    ) external view returns (string memory accountAddress);
+}
@@ -0,0 +1,10 @@
+package ics27
+
+const (
+	// ErrEmptyMsgs is raised when an interchain account transaction has no messages.
+	ErrEmptyMsgs = "no messages to send"
+	// ErrInvalidMemo is raised when a source callback cannot be added to the memo.
+	ErrInvalidMemo = "invalid memo: %s"
+	// ErrInvalidTimeout is raised when the relative timeout is zero.
+	ErrInvalidTimeout = "relative timeout must be non-zero"
+)
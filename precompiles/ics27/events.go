@@ -0,0 +1,95 @@
+package ics27
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeInterchainAccountRegistered defines the event type for the ICS27
+	// RegisterInterchainAccount transaction.
+	EventTypeInterchainAccountRegistered = "InterchainAccountRegistered"
+	// EventTypeInterchainTxSent defines the event type for the ICS27 SendTx transaction.
+	EventTypeInterchainTxSent = "InterchainTxSent"
+)
+
+// EventInterchainAccountRegistered is the event emitted when the registration
+// of an interchain account is initiated
+type EventInterchainAccountRegistered struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	PortId       string //nolint:revive
+	ChannelId    string //nolint:revive
+}
+
+// EventInterchainTxSent is the event emitted when an interchain account
+// transaction is sent
+type EventInterchainTxSent struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	Sequence     uint64
+}
+
+// EmitInterchainAccountRegisteredEvent emits the InterchainAccountRegistered event
+func (p Precompile) EmitInterchainAccountRegisteredEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID, channelID string,
+) error {
+	return p.emitOwnerEvent(ctx, stateDB, EventTypeInterchainAccountRegistered, owner, connectionID, portID, channelID)
+}
+
+// EmitInterchainTxSentEvent emits the InterchainTxSent event
+func (p Precompile) EmitInterchainTxSentEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+) error {
+	return p.emitOwnerEvent(ctx, stateDB, EventTypeInterchainTxSent, owner, connectionID, sequence)
+}
+
+// emitOwnerEvent emits an event with the owner as topic and the remaining
+// event arguments as data.
+func (p Precompile) emitOwnerEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	owner common.Address,
+	data ...interface{},
+) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package ics27
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICS27
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICS27 SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the opening of an interchain account
+// channel owned by the caller. The channel handshake is completed by relayers.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	res, err := p.icaMsgServer.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitInterchainAccountRegisteredEvent(ctx, stateDB, owner, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.PortId, res.ChannelId)
+}
+
+// SendTx sends an interchain account packet executing the given messages with
+// the interchain account of the caller on the host chain.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	isContract := func(addr common.Address) bool {
+		return stateDB.GetCodeSize(addr) > 0
+	}
+
+	msg, owner, err := NewMsgSendTx(method, args, isContract, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	res, err := p.icaMsgServer.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitInterchainTxSentEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
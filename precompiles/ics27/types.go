@@ -0,0 +1,211 @@
+package ics27
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// CosmosMsg defines the ABI representation of a protobuf encoded Cosmos message.
+type CosmosMsg struct {
+	TypeUrl string `abi:"typeUrl"` //nolint:revive
+	Value   []byte `abi:"value"`
+}
+
+// SendTxInput defines the input of the sendTx transaction.
+type SendTxInput struct {
+	Owner           common.Address `abi:"owner"`
+	ConnectionId    string         `abi:"connectionId"` //nolint:revive
+	Msgs            []CosmosMsg    `abi:"msgs"`
+	Memo            string         `abi:"memo"`
+	RelativeTimeout uint64         `abi:"relativeTimeout"`
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance and returns the owner address. The channel is unordered.
+func NewMsgRegisterInterchainAccount(
+	args []interface{},
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, ownerAddr, err := parseOwner(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "connectionId", "", args[1])
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "version", "", args[2])
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, ownerAddr, version, channeltypes.UNORDERED)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance and returns the owner address.
+// The messages are packed in a protobuf encoded CosmosTx. When isContract is
+// true, a source callback to the owner is added to the memo so that the packet
+// acknowledgement or timeout is delivered to the owner contract.
+func NewMsgSendTx(
+	method *abi.Method,
+	args []interface{},
+	isContract func(common.Address) bool,
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgSendTx, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input SendTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SendTxInput: %s", err)
+	}
+
+	owner, ownerAddr, err := parseOwner(input.Owner, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if len(input.Msgs) == 0 {
+		return nil, common.Address{}, errors.New(ErrEmptyMsgs)
+	}
+
+	if input.RelativeTimeout == 0 {
+		return nil, common.Address{}, errors.New(ErrInvalidTimeout)
+	}
+
+	data, err := newCosmosTx(input.Msgs)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	memo := input.Memo
+	if isContract(owner) {
+		memo, err = addSourceCallback(memo, owner)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(ownerAddr, input.ConnectionId, input.RelativeTimeout, icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	})
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewInterchainAccountRequest creates a new QueryInterchainAccountRequest
+// instance from the interchainAccount query arguments.
+func NewInterchainAccountRequest(
+	args []interface{},
+	addrCdc address.Codec,
+) (*icacontrollertypes.QueryInterchainAccountRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	_, ownerAddr, err := parseOwner(args[0], addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "connectionId", "", args[1])
+	}
+
+	return &icacontrollertypes.QueryInterchainAccountRequest{
+		Owner:        ownerAddr,
+		ConnectionId: connectionID,
+	}, nil
+}
+
+// newCosmosTx packs the messages in a protobuf encoded CosmosTx. The messages
+// are not decoded, since they only need to be known by the host chain.
+func newCosmosTx(msgs []CosmosMsg) ([]byte, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.TypeUrl == "" {
+			return nil, fmt.Errorf("empty type URL of message %d", i)
+		}
+		anys[i] = &codectypes.Any{
+			TypeUrl: msg.TypeUrl,
+			Value:   msg.Value,
+		}
+	}
+
+	return proto.Marshal(&icatypes.CosmosTx{Messages: anys})
+}
+
+// addSourceCallback adds a source callback to the owner contract to the JSON
+// memo, unless the memo already defines a source callback.
+func addSourceCallback(memo string, owner common.Address) (string, error) {
+	memoMap := make(map[string]interface{})
+	if memo != "" {
+		if err := json.Unmarshal([]byte(memo), &memoMap); err != nil {
+			return "", fmt.Errorf(ErrInvalidMemo, err)
+		}
+	}
+
+	if _, found := memoMap[callbacktypes.SourceCallbackKey]; found {
+		return memo, nil
+	}
+
+	memoMap[callbacktypes.SourceCallbackKey] = map[string]interface{}{
+		callbacktypes.CallbackAddressKey: owner.Hex(),
+	}
+
+	bz, err := json.Marshal(memoMap)
+	if err != nil {
+		return "", fmt.Errorf(ErrInvalidMemo, err)
+	}
+
+	return string(bz), nil
+}
+
+// parseOwner parses the owner hex address and returns it along with its
+// bech32 representation, which is the owner of the interchain account.
+func parseOwner(ownerArg interface{}, addrCdc address.Codec) (common.Address, string, error) {
+	owner, ok := ownerArg.(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidHexAddress, ownerArg)
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return common.Address{}, "", fmt.Errorf("failed to convert owner address: %w", err)
+	}
+
+	return owner, ownerAddr, nil
+}
@@ -0,0 +1,166 @@
+package ics27
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const connectionID = "connection-0"
+
+var (
+	owner = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	msgs  = []CosmosMsg{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{0x01}}}
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	msg, gotOwner, err := NewMsgRegisterInterchainAccount([]interface{}{owner, connectionID, ""}, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, owner, gotOwner)
+	require.Equal(t, ownerAddr, msg.Owner)
+	require.Equal(t, connectionID, msg.ConnectionId)
+	require.Equal(t, channeltypes.UNORDERED, msg.Ordering)
+
+	_, _, err = NewMsgRegisterInterchainAccount([]interface{}{owner, connectionID}, addrCdc)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2))
+
+	_, _, err = NewMsgRegisterInterchainAccount([]interface{}{common.Address{}, connectionID, ""}, addrCdc)
+	require.ErrorContains(t, err, "invalid hex address")
+
+	_, _, err = NewMsgRegisterInterchainAccount([]interface{}{owner, "invalid", ""}, addrCdc)
+	require.Error(t, err)
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	method := ABI.Methods[SendTxMethod]
+	callbackMemo := fmt.Sprintf(`{"src_callback":{"address":"%s"}}`, owner.Hex())
+
+	tests := []struct {
+		name       string
+		args       []interface{}
+		isContract bool
+		expMemo    string
+		errMsg     string
+	}{
+		{
+			name:    "owner is not a contract",
+			args:    []interface{}{owner, connectionID, msgs, "memo", uint64(100)},
+			expMemo: "memo",
+		},
+		{
+			name:       "owner is a contract",
+			args:       []interface{}{owner, connectionID, msgs, "", uint64(100)},
+			isContract: true,
+			expMemo:    callbackMemo,
+		},
+		{
+			name:       "owner is a contract with a JSON memo",
+			args:       []interface{}{owner, connectionID, msgs, `{"key":"value"}`, uint64(100)},
+			isContract: true,
+			expMemo:    fmt.Sprintf(`{"key":"value","src_callback":{"address":"%s"}}`, owner.Hex()),
+		},
+		{
+			name:       "owner is a contract with a source callback",
+			args:       []interface{}{owner, connectionID, msgs, `{"src_callback":{"address":"other"}}`, uint64(100)},
+			isContract: true,
+			expMemo:    `{"src_callback":{"address":"other"}}`,
+		},
+		{
+			name:       "owner is a contract with an invalid memo",
+			args:       []interface{}{owner, connectionID, msgs, "memo", uint64(100)},
+			isContract: true,
+			errMsg:     "invalid memo",
+		},
+		{
+			name:   "invalid number of arguments",
+			args:   []interface{}{owner, connectionID, msgs, ""},
+			errMsg: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 4),
+		},
+		{
+			name:   "no messages",
+			args:   []interface{}{owner, connectionID, []CosmosMsg{}, "", uint64(100)},
+			errMsg: ErrEmptyMsgs,
+		},
+		{
+			name:   "empty type URL",
+			args:   []interface{}{owner, connectionID, []CosmosMsg{{Value: []byte{0x01}}}, "", uint64(100)},
+			errMsg: "empty type URL",
+		},
+		{
+			name:   "zero timeout",
+			args:   []interface{}{owner, connectionID, msgs, "", uint64(0)},
+			errMsg: ErrInvalidTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isContract := func(common.Address) bool { return tt.isContract }
+			msg, gotOwner, err := NewMsgSendTx(&method, tt.args, isContract, addrCdc)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, owner, gotOwner)
+			require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+			require.Equal(t, uint64(100), msg.RelativeTimeout)
+
+			if tt.isContract {
+				require.JSONEq(t, tt.expMemo, msg.PacketData.Memo)
+			} else {
+				require.Equal(t, tt.expMemo, msg.PacketData.Memo)
+			}
+
+			var cosmosTx icatypes.CosmosTx
+			require.NoError(t, proto.Unmarshal(msg.PacketData.Data, &cosmosTx))
+			require.Len(t, cosmosTx.Messages, 1)
+			require.Equal(t, msgs[0].TypeUrl, cosmosTx.Messages[0].TypeUrl)
+			require.Equal(t, msgs[0].Value, cosmosTx.Messages[0].Value)
+		})
+	}
+}
+
+func TestNewInterchainAccountRequest(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	req, err := NewInterchainAccountRequest([]interface{}{owner, connectionID}, addrCdc)
+	require.NoError(t, err)
+	require.Equal(t, ownerAddr, req.Owner)
+	require.Equal(t, connectionID, req.ConnectionId)
+
+	_, err = NewInterchainAccountRequest([]interface{}{owner}, addrCdc)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+
+	_, err = NewInterchainAccountRequest([]interface{}{owner, 1}, addrCdc)
+	require.Error(t, err)
+}
+
+func TestAddSourceCallback(t *testing.T) {
+	memo, err := addSourceCallback("", owner)
+	require.NoError(t, err)
+
+	var memoMap map[string]map[string]string
+	require.NoError(t, json.Unmarshal([]byte(memo), &memoMap))
+	require.Equal(t, owner.Hex(), memoMap["src_callback"]["address"])
+}
@@ -14,19 +14,24 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Optionals define some optional params that can be applied to _some_ precompiles.
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
-	ValidatorAddrCodec address.Codec // used by slashing
-	ConsensusAddrCodec address.Codec // used by slashing
+	AddressCodec         address.Codec // used by gov/staking/vesting/authz/feegrant/ics27
+	ValidatorAddrCodec   address.Codec // used by slashing
+	ConsensusAddrCodec   address.Codec // used by slashing
+	AuthzAllowedMsgTypes []string      // used by authz
 }
 
 func defaultOptionals() Optionals {
@@ -34,6 +39,20 @@ func defaultOptionals() Optionals {
 		AddressCodec:       evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32AccountAddrPrefix()),
 		ValidatorAddrCodec: evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ValidatorAddrPrefix()),
 		ConsensusAddrCodec: evmaddress.NewEvmCodec(sdktypes.GetConfig().GetBech32ConsensusAddrPrefix()),
+		// msg types that do not route back into the EVM
+		AuthzAllowedMsgTypes: []string{
+			sdktypes.MsgTypeURL(&banktypes.MsgSend{}),
+			sdktypes.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+			sdktypes.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+			sdktypes.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+			sdktypes.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+			sdktypes.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+			sdktypes.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}),
+			sdktypes.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+			sdktypes.MsgTypeURL(&govv1.MsgVote{}),
+			sdktypes.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+			sdktypes.MsgTypeURL(&govv1.MsgDeposit{}),
+		},
 	}
 }
 
@@ -57,6 +76,12 @@ func WithConsensusAddrCodec(codec address.Codec) Option {
 	}
 }
 
+func WithAuthzAllowedMsgTypes(msgTypes ...string) Option {
+	return func(opts *Optionals) {
+		opts.AuthzAllowedMsgTypes = msgTypes
+	}
+}
+
 const bech32PrecompileBaseGas = 6_000
 
 // DefaultStaticPrecompiles returns the list of all available static precompiled contracts from Cosmos EVM.
@@ -7,20 +7,31 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
+	ics27precompile "github.com/cosmos/evm/precompiles/ics27"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	vestingprecompile "github.com/cosmos/evm/precompiles/vesting"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -29,6 +40,13 @@ import (
 
 type StaticPrecompiles map[common.Address]vm.PrecompiledContract
 
+// VestingBankKeeper is the bank keeper of the vesting precompile, its msg
+// server also checks that the coins are send enabled.
+type VestingBankKeeper interface {
+	cmn.BankKeeper
+	vestingtypes.BankKeeper
+}
+
 func NewStaticPrecompiles() StaticPrecompiles {
 	return make(StaticPrecompiles)
 }
@@ -169,3 +187,92 @@ func (s StaticPrecompiles) WithSlashingPrecompile(
 	s[slashingPrecompile.Address()] = slashingPrecompile
 	return s
 }
+
+func (s StaticPrecompiles) WithVestingPrecompile(
+	accountKeeper authkeeper.AccountKeeper,
+	bankKeeper VestingBankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	vestingPrecompile := vestingprecompile.NewPrecompile(
+		accountKeeper,
+		vesting.NewMsgServerImpl(accountKeeper, bankKeeper),
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[vestingPrecompile.Address()] = vestingPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithAuthzPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	codec codec.Codec,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	authzPrecompile := authzprecompile.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		bankKeeper,
+		codec,
+		options.AddressCodec,
+		options.AuthzAllowedMsgTypes...,
+	)
+
+	s[authzPrecompile.Address()] = authzPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithFeegrantPrecompile(
+	feegrantKeeper feegrantkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	feegrantPrecompile := feegrantprecompile.NewPrecompile(
+		feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		feegrantKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[feegrantPrecompile.Address()] = feegrantPrecompile
+	return s
+}
+
+func (s StaticPrecompiles) WithICS27Precompile(
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	opts ...Option,
+) StaticPrecompiles {
+	options := defaultOptionals()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// the keeper is passed by reference, since its ICS4Wrapper is set after
+	// the IBC application stack is created
+	ics27Precompile := ics27precompile.NewPrecompile(
+		icacontrollerkeeper.NewMsgServerImpl(icaControllerKeeper),
+		icaControllerKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	s[ics27Precompile.Address()] = ics27Precompile
+	return s
+}
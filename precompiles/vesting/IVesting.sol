@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IVesting contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The IVesting contract's instance.
+IVesting constant VESTING_CONTRACT = IVesting(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Period defines a length of time and the amount of coins that vest
+/// at the end of it.
+struct Period {
+    /// @dev Length of the period in seconds
+    int64 length;
+    /// @dev Amount of coins vesting at the end of the period
+    Coin[] amount;
+}
+
+/// @dev VestingSchedule defines the vesting schedule of a vesting account.
+struct VestingSchedule {
+    /// @dev Type of the vesting account: continuous, delayed, periodic or permanent_locked
+    string accountType;
+    /// @dev Coins locked in the account when it was created
+    Coin[] originalVesting;
+    /// @dev Vested coins delegated by the account
+    Coin[] delegatedFree;
+    /// @dev Vesting coins delegated by the account
+    Coin[] delegatedVesting;
+    /// @dev Unix time at which the vesting starts, zero for delayed and permanent locked accounts
+    int64 startTime;
+    /// @dev Unix time at which all coins are vested, zero for permanent locked accounts
+    int64 endTime;
+    /// @dev Vesting periods of a periodic vesting account
+    Period[] periods;
+}
+
+/// @author Evmos Team
+/// @title Vesting Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with vesting accounts
+/// @custom:address 0x0000000000000000000000000000000000000803
+interface IVesting {
+    /// @dev Emitted when a vesting account is created.
+    /// @param funder The address of the account funding the vesting account
+    /// @param vestingAddress The address of the created vesting account
+    /// @param accountType The type of the created vesting account
+    event CreateVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        string accountType
+    );
+
+    /// @dev Creates a vesting account whose coins vest linearly from the
+    /// current block time until the end time.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the vesting account to create
+    /// @param amount The coins to vest
+    /// @param endTime The unix time at which all coins are vested
+    /// @return success Whether the vesting account was created
+    function createContinuousVestingAccount(
+        address funder,
+        address to,
+        Coin[] calldata amount,
+        int64 endTime
+    ) external returns (bool success);
+
+    /// @dev Creates a vesting account whose coins all vest at the end time.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the vesting account to create
+    /// @param amount The coins to vest
+    /// @param endTime The unix time at which all coins are vested
+    /// @return success Whether the vesting account was created
+    function createDelayedVestingAccount(
+        address funder,
+        address to,
+        Coin[] calldata amount,
+        int64 endTime
+    ) external returns (bool success);
+
+    /// @dev Creates a vesting account whose coins vest at the end of each
+    /// of the given periods, the first one starting at the start time.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the vesting account to create
+    /// @param startTime The unix time at which the first period starts
+    /// @param periods The vesting periods
+    /// @return success Whether the vesting account was created
+    function createPeriodicVestingAccount(
+        address funder,
+        address to,
+        int64 startTime,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// @dev Returns the vesting schedule of a vesting account.
+    /// @param account The address of the vesting account
+    /// @return schedule The vesting schedule of the account
+    function getVestingSchedule(
+        address account
+    ) external view returns (VestingSchedule memory schedule);
+
+    /// @dev Returns the locked, unvested and vested coins of a vesting
+    /// account at the current block time.
+    /// @param account The address of the vesting account
+    /// @return locked The coins that cannot be transferred
+    /// @return unvested The coins that are not vested yet
+    /// @return vested The coins that are vested
+    function balances(
+        address account
+    )
+        external
+        view
+        returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+}
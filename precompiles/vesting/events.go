@@ -0,0 +1,64 @@
+package vesting
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeCreateVestingAccount defines the event type for the vesting account creation
+	EventTypeCreateVestingAccount = "CreateVestingAccount"
+)
+
+// EventCreateVestingAccount is the event emitted when a vesting account is created
+type EventCreateVestingAccount struct {
+	Funder         common.Address
+	VestingAddress common.Address
+	AccountType    string
+}
+
+// EmitCreateVestingAccountEvent emits the CreateVestingAccount event
+func (p Precompile) EmitCreateVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	accountType string,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateVestingAccount]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funder)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	data, err := event.Inputs.NonIndexed().Pack(accountType)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
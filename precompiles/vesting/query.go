@@ -0,0 +1,83 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+const (
+	// GetVestingScheduleMethod defines the ABI method name for the vesting schedule query
+	GetVestingScheduleMethod = "getVestingSchedule"
+	// BalancesMethod defines the ABI method name for the vesting balances query
+	BalancesMethod = "balances"
+)
+
+// GetVestingSchedule returns the vesting schedule of a vesting account.
+func (p Precompile) GetVestingSchedule(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAcc, err := p.getVestingAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(VestingScheduleOutput).FromAccount(vestingAcc)
+	return method.Outputs.Pack(out.Schedule)
+}
+
+// Balances returns the locked, unvested and vested coins of a vesting account
+// at the current block time.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAcc, err := p.getVestingAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+	return method.Outputs.Pack(
+		cmn.NewCoinsResponse(vestingAcc.LockedCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestingCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestedCoins(blockTime)),
+	)
+}
+
+// getVestingAccount returns the vesting account at the given address.
+func (p Precompile) getVestingAccount(ctx sdk.Context, account common.Address) (vestingexported.VestingAccount, error) {
+	acc := p.accountKeeper.GetAccount(ctx, account.Bytes())
+	if acc == nil {
+		return nil, fmt.Errorf("account %s does not exist", account)
+	}
+
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return nil, fmt.Errorf("account %s is not a vesting account", account)
+	}
+
+	return vestingAcc, nil
+}
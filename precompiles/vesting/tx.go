@@ -0,0 +1,111 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateContinuousVestingAccountMethod defines the ABI method name for the
+	// continuous vesting account creation transaction.
+	CreateContinuousVestingAccountMethod = "createContinuousVestingAccount"
+	// CreateDelayedVestingAccountMethod defines the ABI method name for the
+	// delayed vesting account creation transaction.
+	CreateDelayedVestingAccountMethod = "createDelayedVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the
+	// periodic vesting account creation transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
+)
+
+// CreateVestingAccount creates a continuous or delayed vesting account, funded
+// by the caller, whose coins vest until the given end time.
+func (p Precompile) CreateVestingAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	delayed := method.Name == CreateDelayedVestingAccountMethod
+	msg, funder, to, err := NewMsgCreateVestingAccount(method, args, p.addrCdc, delayed)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+
+	if _, err := p.vestingMsgServer.CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+	p.syncBalances(ctx, stateDB, funder, to)
+
+	accountType := AccountTypeContinuous
+	if delayed {
+		accountType = AccountTypeDelayed
+	}
+	if err := p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, to, accountType); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePeriodicVestingAccount creates a periodic vesting account, funded by the
+// caller, whose coins vest at the end of each of the given periods.
+func (p Precompile) CreatePeriodicVestingAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, to, err := NewMsgCreatePeriodicVestingAccount(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+
+	if _, err := p.vestingMsgServer.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+	p.syncBalances(ctx, stateDB, funder, to)
+
+	if err := p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, to, AccountTypePeriodic); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// syncBalances sets the EVM balances of the given accounts to their spendable
+// coins, which exclude the locked coins of the vesting accounts.
+func (p Precompile) syncBalances(ctx sdk.Context, stateDB vm.StateDB, addrs ...common.Address) {
+	for _, addr := range addrs {
+		spendable := p.bankKeeper.SpendableCoin(ctx, addr.Bytes(), evmtypes.GetEVMCoinDenom())
+		balance := uint256.MustFromBig(spendable.Amount.BigInt())
+		current := stateDB.GetBalance(addr)
+		switch balance.Cmp(current) {
+		case 1:
+			stateDB.AddBalance(addr, new(uint256.Int).Sub(balance, current), tracing.BalanceChangeUnspecified)
+		case -1:
+			stateDB.SubBalance(addr, new(uint256.Int).Sub(current, balance), tracing.BalanceChangeUnspecified)
+		}
+	}
+}
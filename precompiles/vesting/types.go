@@ -0,0 +1,229 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// AccountTypeContinuous defines the type of a continuous vesting account
+	AccountTypeContinuous = "continuous"
+	// AccountTypeDelayed defines the type of a delayed vesting account
+	AccountTypeDelayed = "delayed"
+	// AccountTypePeriodic defines the type of a periodic vesting account
+	AccountTypePeriodic = "periodic"
+	// AccountTypePermanentLocked defines the type of a permanent locked account
+	AccountTypePermanentLocked = "permanent_locked"
+)
+
+// Period represents a vesting period
+type Period struct {
+	Length int64      `abi:"length"`
+	Amount []cmn.Coin `abi:"amount"`
+}
+
+// VestingSchedule represents the vesting schedule of a vesting account
+type VestingSchedule struct {
+	AccountType      string     `abi:"accountType"`
+	OriginalVesting  []cmn.Coin `abi:"originalVesting"`
+	DelegatedFree    []cmn.Coin `abi:"delegatedFree"`
+	DelegatedVesting []cmn.Coin `abi:"delegatedVesting"`
+	StartTime        int64      `abi:"startTime"`
+	EndTime          int64      `abi:"endTime"`
+	Periods          []Period   `abi:"periods"`
+}
+
+// CreateVestingAccountInput represents the input of the continuous and
+// delayed vesting account creation
+type CreateVestingAccountInput struct {
+	Funder  common.Address `abi:"funder"`
+	To      common.Address `abi:"to"`
+	Amount  []cmn.Coin     `abi:"amount"`
+	EndTime int64          `abi:"endTime"`
+}
+
+// CreatePeriodicVestingAccountInput represents the input of the periodic
+// vesting account creation
+type CreatePeriodicVestingAccountInput struct {
+	Funder    common.Address `abi:"funder"`
+	To        common.Address `abi:"to"`
+	StartTime int64          `abi:"startTime"`
+	Periods   []Period       `abi:"periods"`
+}
+
+// VestingScheduleOutput represents the output of the vesting schedule query
+type VestingScheduleOutput struct {
+	Schedule VestingSchedule
+}
+
+// BalancesOutput represents the output of the vesting balances query
+type BalancesOutput struct {
+	Locked   []cmn.Coin `abi:"locked"`
+	Unvested []cmn.Coin `abi:"unvested"`
+	Vested   []cmn.Coin `abi:"vested"`
+}
+
+// NewMsgCreateVestingAccount creates a new MsgCreateVestingAccount instance from
+// the given arguments, the account is delayed if the delayed flag is set.
+// It also returns the funder and the vesting account addresses.
+func NewMsgCreateVestingAccount(
+	method *abi.Method,
+	args []interface{},
+	addrCdc address.Codec,
+	delayed bool,
+) (*vestingtypes.MsgCreateVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input CreateVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to CreateVestingAccountInput: %s", err)
+	}
+
+	fromAddr, toAddr, err := bech32Addresses(addrCdc, input.Funder, input.To)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	msg := &vestingtypes.MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     input.EndTime,
+		Delayed:     delayed,
+	}
+
+	return msg, input.Funder, input.To, nil
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount
+// instance from the given arguments. It also returns the funder and the vesting
+// account addresses.
+func NewMsgCreatePeriodicVestingAccount(
+	method *abi.Method,
+	args []interface{},
+	addrCdc address.Codec,
+) (*vestingtypes.MsgCreatePeriodicVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input CreatePeriodicVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to CreatePeriodicVestingAccountInput: %s", err)
+	}
+
+	fromAddr, toAddr, err := bech32Addresses(addrCdc, input.Funder, input.To)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	periods := make([]vestingtypes.Period, len(input.Periods))
+	for i, period := range input.Periods {
+		amount, err := cmn.NewSdkCoinsFromCoins(period.Amount)
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid amount of period %d: %w", i, err)
+		}
+		periods[i] = vestingtypes.Period{Length: period.Length, Amount: amount}
+	}
+
+	msg := &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      input.StartTime,
+		VestingPeriods: periods,
+	}
+
+	return msg, input.Funder, input.To, nil
+}
+
+// ParseAccountArgs parses the account address argument of the vesting queries.
+func ParseAccountArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidHexAddress, args[0])
+	}
+
+	return account, nil
+}
+
+// FromAccount populates the VestingScheduleOutput from a vesting account.
+func (vo *VestingScheduleOutput) FromAccount(account vestingexported.VestingAccount) *VestingScheduleOutput {
+	vo.Schedule = VestingSchedule{
+		AccountType:      AccountType(account),
+		OriginalVesting:  cmn.NewCoinsResponse(account.GetOriginalVesting()),
+		DelegatedFree:    cmn.NewCoinsResponse(account.GetDelegatedFree()),
+		DelegatedVesting: cmn.NewCoinsResponse(account.GetDelegatedVesting()),
+		StartTime:        account.GetStartTime(),
+		EndTime:          account.GetEndTime(),
+		Periods:          []Period{},
+	}
+
+	if periodic, ok := account.(*vestingtypes.PeriodicVestingAccount); ok {
+		for _, period := range periodic.GetVestingPeriods() {
+			vo.Schedule.Periods = append(vo.Schedule.Periods, Period{
+				Length: period.Length,
+				Amount: cmn.NewCoinsResponse(period.Amount),
+			})
+		}
+	}
+
+	return vo
+}
+
+// AccountType returns the type of the given vesting account.
+func AccountType(account vestingexported.VestingAccount) string {
+	switch account.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		return AccountTypeContinuous
+	case *vestingtypes.DelayedVestingAccount:
+		return AccountTypeDelayed
+	case *vestingtypes.PeriodicVestingAccount:
+		return AccountTypePeriodic
+	case *vestingtypes.PermanentLockedAccount:
+		return AccountTypePermanentLocked
+	default:
+		return ""
+	}
+}
+
+// bech32Addresses converts the funder and the vesting account addresses to
+// their bech32 representation.
+func bech32Addresses(addrCdc address.Codec, funder, to common.Address) (string, string, error) {
+	if funder == (common.Address{}) {
+		return "", "", fmt.Errorf(cmn.ErrInvalidHexAddress, funder)
+	}
+	if to == (common.Address{}) {
+		return "", "", fmt.Errorf(cmn.ErrInvalidHexAddress, to)
+	}
+
+	fromAddr, err := addrCdc.BytesToString(funder.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert funder address: %w", err)
+	}
+	toAddr, err := addrCdc.BytesToString(to.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert vesting account address: %w", err)
+	}
+
+	return fromAddr, toAddr, nil
+}
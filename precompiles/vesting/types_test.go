@@ -0,0 +1,202 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// unpackArgs packs the given values as the inputs of the method and unpacks
+// them, as the precompile receives them.
+func unpackArgs(t *testing.T, methodName string, values ...interface{}) []interface{} {
+	t.Helper()
+	method := ABI.Methods[methodName]
+	bz, err := method.Inputs.Pack(values...)
+	require.NoError(t, err)
+	args, err := method.Inputs.Unpack(bz)
+	require.NoError(t, err)
+	return args
+}
+
+func TestNewMsgCreateVestingAccount(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	funder := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	to := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	funderBech32, err := addrCdc.BytesToString(funder.Bytes())
+	require.NoError(t, err)
+	toBech32, err := addrCdc.BytesToString(to.Bytes())
+	require.NoError(t, err)
+	amount := []cmn.Coin{{Denom: "bbb", Amount: big.NewInt(2)}, {Denom: "aaa", Amount: big.NewInt(1)}}
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		delayed bool
+		errMsg  string
+	}{
+		{
+			name:    "continuous",
+			args:    unpackArgs(t, CreateContinuousVestingAccountMethod, funder, to, amount, int64(100)),
+			delayed: false,
+		},
+		{
+			name:    "delayed",
+			args:    unpackArgs(t, CreateDelayedVestingAccountMethod, funder, to, amount, int64(100)),
+			delayed: true,
+		},
+		{
+			name:   "invalid number of arguments",
+			args:   []interface{}{funder, to},
+			errMsg: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 2),
+		},
+		{
+			name:   "empty funder",
+			args:   unpackArgs(t, CreateContinuousVestingAccountMethod, common.Address{}, to, amount, int64(100)),
+			errMsg: fmt.Sprintf(cmn.ErrInvalidHexAddress, common.Address{}),
+		},
+		{
+			name:   "invalid amount",
+			args:   unpackArgs(t, CreateContinuousVestingAccountMethod, funder, to, []cmn.Coin{{Denom: "", Amount: big.NewInt(1)}}, int64(100)),
+			errMsg: "invalid amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := ABI.Methods[CreateContinuousVestingAccountMethod]
+			msg, gotFunder, gotTo, err := NewMsgCreateVestingAccount(&method, tt.args, addrCdc, tt.delayed)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, funder, gotFunder)
+			require.Equal(t, to, gotTo)
+			require.Equal(t, &vestingtypes.MsgCreateVestingAccount{
+				FromAddress: funderBech32,
+				ToAddress:   toBech32,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("aaa", 1), sdk.NewInt64Coin("bbb", 2)),
+				EndTime:     100,
+				Delayed:     tt.delayed,
+			}, msg)
+		})
+	}
+}
+
+func TestNewMsgCreatePeriodicVestingAccount(t *testing.T) {
+	addrCdc := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	funder := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	to := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	funderBech32, err := addrCdc.BytesToString(funder.Bytes())
+	require.NoError(t, err)
+	toBech32, err := addrCdc.BytesToString(to.Bytes())
+	require.NoError(t, err)
+	periods := []Period{
+		{Length: 10, Amount: []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(1)}}},
+		{Length: 20, Amount: []cmn.Coin{{Denom: "aaa", Amount: big.NewInt(2)}}},
+	}
+
+	method := ABI.Methods[CreatePeriodicVestingAccountMethod]
+	msg, gotFunder, gotTo, err := NewMsgCreatePeriodicVestingAccount(
+		&method,
+		unpackArgs(t, CreatePeriodicVestingAccountMethod, funder, to, int64(50), periods),
+		addrCdc,
+	)
+	require.NoError(t, err)
+	require.Equal(t, funder, gotFunder)
+	require.Equal(t, to, gotTo)
+	require.Equal(t, &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FromAddress: funderBech32,
+		ToAddress:   toBech32,
+		StartTime:   50,
+		VestingPeriods: []vestingtypes.Period{
+			{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("aaa", 1))},
+			{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("aaa", 2))},
+		},
+	}, msg)
+
+	invalidPeriods := []Period{{Length: 10, Amount: []cmn.Coin{{Denom: "", Amount: big.NewInt(1)}}}}
+	_, _, _, err = NewMsgCreatePeriodicVestingAccount(
+		&method,
+		unpackArgs(t, CreatePeriodicVestingAccountMethod, funder, to, int64(50), invalidPeriods),
+		addrCdc,
+	)
+	require.ErrorContains(t, err, "invalid amount of period 0")
+}
+
+func TestParseAccountArgs(t *testing.T) {
+	account := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	got, err := ParseAccountArgs([]interface{}{account})
+	require.NoError(t, err)
+	require.Equal(t, account, got)
+
+	_, err = ParseAccountArgs([]interface{}{})
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+
+	_, err = ParseAccountArgs([]interface{}{common.Address{}})
+	require.ErrorContains(t, err, "invalid hex address")
+
+	_, err = ParseAccountArgs([]interface{}{"not-an-address"})
+	require.ErrorContains(t, err, "invalid hex address")
+}
+
+func TestVestingScheduleOutputFromAccount(t *testing.T) {
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(common.HexToAddress("0x1234").Bytes()))
+	coins := sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(3)))
+	start := time.Unix(100, 0)
+
+	continuous, err := vestingtypes.NewContinuousVestingAccount(baseAcc, coins, start.Unix(), 200)
+	require.NoError(t, err)
+	out := new(VestingScheduleOutput).FromAccount(continuous)
+	require.Equal(t, VestingSchedule{
+		AccountType:      AccountTypeContinuous,
+		OriginalVesting:  cmn.NewCoinsResponse(coins),
+		DelegatedFree:    []cmn.Coin{},
+		DelegatedVesting: []cmn.Coin{},
+		StartTime:        100,
+		EndTime:          200,
+		Periods:          []Period{},
+	}, out.Schedule)
+
+	delayed, err := vestingtypes.NewDelayedVestingAccount(baseAcc, coins, 200)
+	require.NoError(t, err)
+	out = new(VestingScheduleOutput).FromAccount(delayed)
+	require.Equal(t, AccountTypeDelayed, out.Schedule.AccountType)
+	require.Equal(t, int64(0), out.Schedule.StartTime)
+	require.Equal(t, int64(200), out.Schedule.EndTime)
+
+	periods := vestingtypes.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(1)))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(2)))},
+	}
+	periodic, err := vestingtypes.NewPeriodicVestingAccount(baseAcc, coins, start.Unix(), periods)
+	require.NoError(t, err)
+	out = new(VestingScheduleOutput).FromAccount(periodic)
+	require.Equal(t, AccountTypePeriodic, out.Schedule.AccountType)
+	require.Equal(t, int64(130), out.Schedule.EndTime)
+	require.Equal(t, []Period{
+		{Length: 10, Amount: cmn.NewCoinsResponse(periods[0].Amount)},
+		{Length: 20, Amount: cmn.NewCoinsResponse(periods[1].Amount)},
+	}, out.Schedule.Periods)
+
+	// the schedule is packed as the output of the query
+	method := ABI.Methods[GetVestingScheduleMethod]
+	_, err = method.Outputs.Pack(out.Schedule)
+	require.NoError(t, err)
+}
@@ -36,9 +36,10 @@ func (p Precompile) Deposit(
 	callerAccAddress := sdk.AccAddress(caller.Bytes())
 	precompileAccAddr := sdk.AccAddress(p.Address().Bytes())
 
-	// Send the coins back to the sender
+	// Send the coins back to the sender, the deposit is reported by the
+	// Deposit log instead of a Transfer log
 	if err := p.BankKeeper.SendCoins(
-		ctx,
+		evmtypes.WithPrecompileLogs(ctx, nil),
 		precompileAccAddr,
 		callerAccAddress,
 		sdk.NewCoins(sdk.Coin{
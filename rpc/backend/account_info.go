@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/statemirror"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -70,6 +71,15 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		height = int64(bn) //#nosec G115 -- checked for int overflow already
 	}
 
+	// the state mirror serves Ethereum-format proofs against its state roots,
+	// the heights without a published root fall back to the IAVL proofs
+	if b.StateMirror != nil {
+		res, err := b.StateMirror.GetProof(height, address, storageKeys)
+		if !errors.Is(err, statemirror.ErrNoStateRoot) {
+			return res, err
+		}
+	}
+
 	ctx := rpctypes.ContextWithHeight(height)
 	clientCtx := b.ClientCtx.WithHeight(height)
 
@@ -151,6 +161,14 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
+	if blockNum == rpctypes.EthPendingBlockNumber && b.Mempool != nil {
+		balance, err := b.getPendingBalance(address)
+		if err != nil {
+			return nil, err
+		}
+		return (*hexutil.Big)(balance), nil
+	}
+
 	req := &evmtypes.QueryBalanceRequest{
 		Address: address.String(),
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/statemirror"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -46,6 +47,7 @@ type EVMBackend interface {
 	// Node specific queries
 	Accounts() ([]common.Address, error)
 	Syncing() (interface{}, error)
+	IndexerStatus() (*types.IndexerStatus, error)
 	SetEtherbase(etherbase common.Address) bool
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
@@ -82,6 +84,7 @@ type EVMBackend interface {
 	EthBlockByNumber(blockNum types.BlockNumber) (*ethtypes.Block, error)
 	EthBlockFromCometBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*ethtypes.Block, error)
 	GetBlockReceipts(blockNrOrHash types.BlockNumberOrHash) ([]map[string]interface{}, error)
+	EthBlockReceipts(blockNrOrHash types.BlockNumberOrHash) ([]*ethtypes.Receipt, error)
 
 	// Account Info
 	GetCode(address common.Address, blockNrOrHash types.BlockNumberOrHash) (hexutil.Bytes, error)
@@ -103,11 +106,13 @@ type EVMBackend interface {
 
 	// Tx Info
 	GetTransactionByHash(txHash common.Hash) (*types.RPCTransaction, error)
+	EthTransactionByHash(txHash common.Hash) (*ethtypes.Transaction, error)
 	GetTxByEthHash(txHash common.Hash) (*servertypes.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*servertypes.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
+	GetTransactionsByAddress(address common.Address, args types.AddressTxArgs) (*types.AddressTxsResult, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum types.BlockNumber, idx hexutil.Uint) (*types.RPCTransaction, error)
 	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, overrides *json.RawMessage) (*types.AccessListResult, error)
@@ -115,14 +120,17 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionFromPeer(peer string, data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides, blockOverrides *json.RawMessage) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides, blockOverrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -130,11 +138,17 @@ type EVMBackend interface {
 	ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error)
 	Inspect() (map[string]map[string]map[string]string, error)
 	Status() (map[string]hexutil.Uint, error)
+	CosmosContent() (map[string]map[string]*types.RPCCosmosTransaction, error)
+	CosmosContentFrom(address common.Address) (map[string]*types.RPCCosmosTransaction, error)
+	CosmosInspect() (map[string]map[string]string, error)
+	CosmosStatus() (map[string]hexutil.Uint, error)
+	NextBlock() (*types.NextBlockResult, error)
+	CancelCosmosTransaction(address common.Address, sequence hexutil.Uint64) ([]*types.RPCCosmosTransaction, error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
-	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceCallConfig) (interface{}, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -173,6 +187,11 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+	TraceCache          *TraceCache
+	StateMirror         *statemirror.StateMirror
+	QueuedRateLimiter   *evmmempool.PeerRateLimiter
+
+	pendingCache pendingCache
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -187,6 +206,8 @@ func NewBackend(
 	allowUnprotectedTxs bool,
 	indexer servertypes.EVMTxIndexer,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	traceCache *TraceCache,
+	stateMirror *statemirror.StateMirror,
 ) *Backend {
 	appConf, err := config.GetConfig(ctx.Viper)
 	if err != nil {
@@ -209,6 +230,11 @@ func NewBackend(
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		TraceCache:          traceCache,
+		StateMirror:         stateMirror,
+	}
+	if appConf.EVM.Mempool.QueuedRateLimit > 0 {
+		b.QueuedRateLimiter = evmmempool.NewPeerRateLimiter(appConf.EVM.Mempool.QueuedRateLimit)
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
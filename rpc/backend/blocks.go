@@ -47,6 +47,10 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum == types.EthPendingBlockNumber && b.Mempool != nil {
+		return b.getPendingBlock(fullTx)
+	}
+
 	resBlock, err := b.CometBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -176,30 +180,9 @@ func (b *Backend) EthBlockByNumber(blockNum types.BlockNumber) (*ethtypes.Block,
 func (b *Backend) GetBlockReceipts(
 	blockNrOrHash types.BlockNumberOrHash,
 ) ([]map[string]interface{}, error) {
-	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get block number from hash: %w", err)
-	}
-
-	resBlock, err := b.CometBlockByNumber(blockNum)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get block by number: %w", err)
-	}
-
-	if resBlock == nil {
-		return nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
-	}
-
-	blockRes, err := b.RPCClient.BlockResults(b.Ctx, blockNum.CmtHeight())
-	if err != nil {
-		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
-	}
-
-	msgs := b.EthMsgsFromCometBlock(resBlock, blockRes)
-
-	receipts, err := b.ReceiptsFromCometBlock(resBlock, blockRes, msgs)
+	msgs, receipts, err := b.blockReceipts(blockNrOrHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get receipts from comet block: %w, ", err)
+		return nil, err
 	}
 
 	result := make([]map[string]interface{}, len(msgs))
@@ -223,3 +206,44 @@ func (b *Backend) GetBlockReceipts(
 	}
 	return result, nil
 }
+
+// EthBlockReceipts returns the Ethereum receipts of the transactions of the
+// block identified by number or hash.
+func (b *Backend) EthBlockReceipts(blockNrOrHash types.BlockNumberOrHash) ([]*ethtypes.Receipt, error) {
+	_, receipts, err := b.blockReceipts(blockNrOrHash)
+	return receipts, err
+}
+
+// blockReceipts returns the Ethereum transactions of a block along with their
+// receipts.
+func (b *Backend) blockReceipts(
+	blockNrOrHash types.BlockNumberOrHash,
+) ([]*evmtypes.MsgEthereumTx, []*ethtypes.Receipt, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get block number from hash: %w", err)
+	}
+
+	resBlock, err := b.CometBlockByNumber(blockNum)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get block by number: %w", err)
+	}
+
+	if resBlock == nil {
+		return nil, nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
+	}
+
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, blockNum.CmtHeight())
+	if err != nil {
+		return nil, nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	msgs := b.EthMsgsFromCometBlock(resBlock, blockRes)
+
+	receipts, err := b.ReceiptsFromCometBlock(resBlock, blockRes, msgs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get receipts from comet block: %w, ", err)
+	}
+
+	return msgs, receipts, nil
+}
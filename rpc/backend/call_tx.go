@@ -101,6 +101,13 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	return b.SendRawTransactionFromPeer("", data)
+}
+
+// SendRawTransactionFromPeer send a raw Ethereum transaction submitted by the
+// peer with the given remote address. The transactions queued with a nonce gap
+// are rate limited per peer if the queued rate limit is set.
+func (b *Backend) SendRawTransactionFromPeer(peer string, data hexutil.Bytes) (common.Hash, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -131,6 +138,11 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, fmt.Errorf("failed to validate transaction: %w", err)
 	}
 
+	if b.isQueuedTx(tx, ethSigner) && !b.QueuedRateLimiter.Allow(peer) {
+		b.Logger.Debug("queued tx rate limited", "peer", peer, "hash", tx.Hash().Hex())
+		return common.Hash{}, fmt.Errorf("too many queued transactions submitted by %s, please retry later", peer)
+	}
+
 	baseDenom := evmtypes.GetEVMCoinDenom()
 
 	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
@@ -186,6 +198,20 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// isQueuedTx returns true if the queued rate limit is set and the transaction
+// would be queued in the mempool, i.e. its nonce is ahead of the pending nonce
+// of its sender.
+func (b *Backend) isQueuedTx(tx *ethtypes.Transaction, signer ethtypes.Signer) bool {
+	if b.QueuedRateLimiter == nil || b.Mempool == nil {
+		return false
+	}
+	from, err := signer.Sender(tx)
+	if err != nil {
+		return false
+	}
+	return tx.Nonce() > b.Mempool.GetTxPool().Nonce(from)
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -300,7 +326,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
 		blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
-		estimated, err := b.EstimateGas(callArgs, &blockNrOrHash, nil)
+		estimated, err := b.EstimateGas(callArgs, &blockNrOrHash, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -319,7 +345,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 func (b *Backend) EstimateGas(
 	args evmtypes.TransactionArgs,
 	blockNrOrHash *rpctypes.BlockNumberOrHash,
-	overrides *json.RawMessage,
+	overrides, blockOverrides *json.RawMessage,
 ) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOrHash != nil {
@@ -341,10 +367,13 @@ func (b *Backend) EstimateGas(
 		return 0, errors.New("header not found")
 	}
 
-	var bzOverrides []byte
+	var bzOverrides, bzBlockOverrides []byte
 	if overrides != nil {
 		bzOverrides = *overrides
 	}
+	if blockOverrides != nil {
+		bzBlockOverrides = *blockOverrides
+	}
 
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
@@ -352,6 +381,7 @@ func (b *Backend) EstimateGas(
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 		Overrides:       bzOverrides,
+		BlockOverrides:  bzBlockOverrides,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -372,8 +402,12 @@ func (b *Backend) EstimateGas(
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs,
 	blockNr rpctypes.BlockNumber,
-	overrides *json.RawMessage,
+	overrides, blockOverrides *json.RawMessage,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
+	if blockNr == rpctypes.EthPendingBlockNumber && b.Mempool != nil {
+		return b.doPendingCall(args, overrides, blockOverrides)
+	}
+
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
@@ -384,10 +418,13 @@ func (b *Backend) DoCall(
 		return nil, errors.New("header not found")
 	}
 
-	var bzOverrides []byte
+	var bzOverrides, bzBlockOverrides []byte
 	if overrides != nil {
 		bzOverrides = *overrides
 	}
+	if blockOverrides != nil {
+		bzBlockOverrides = *blockOverrides
+	}
 
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
@@ -395,6 +432,7 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 		Overrides:       bzOverrides,
+		BlockOverrides:  bzBlockOverrides,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -462,6 +500,96 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	return (*hexutil.Big)(result), nil
 }
 
+// SimulateV1 executes a series of blocks of calls on top of the given block
+// and returns the simulated blocks in the same format as eth_getBlockByNumber,
+// with the results of the calls attached.
+func (b *Backend) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: "empty input"}
+	}
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNr, err = b.BlockNumberFromComet(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	results, err := b.simulate(opts, blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]map[string]interface{}, 0, len(results))
+	for _, result := range results {
+		block := ethtypes.NewBlockWithHeader(result.Header).WithBody(ethtypes.Body{Transactions: result.Transactions})
+		fields := rpctypes.RPCMarshalHeader(block.Header(), block.Hash().Bytes())
+		fields["size"] = hexutil.Uint64(block.Size())
+
+		txs := make([]interface{}, len(result.Transactions))
+		for i, tx := range result.Transactions {
+			if !opts.ReturnFullTransactions {
+				txs[i] = tx.Hash()
+				continue
+			}
+			rpcTx := rpctypes.NewRPCTransaction(tx, block.Hash(), block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), b.ChainConfig()) //nolint:gosec // G115 // won't exceed uint64
+			// simulated transactions are not signed, so the sender is taken from the call
+			rpcTx.From = result.Senders[i]
+			txs[i] = rpcTx
+		}
+		fields["transactions"] = txs
+		fields["uncles"] = []common.Hash{}
+		fields["calls"] = result.Calls
+		blocks = append(blocks, fields)
+	}
+	return blocks, nil
+}
+
+// simulate executes the blocks of calls of an eth_simulateV1 request on top of
+// the given block and returns the simulated blocks.
+func (b *Backend) simulate(opts rpctypes.SimOpts, blockNr rpctypes.BlockNumber) ([]rpctypes.SimBlockResult, error) {
+	header, err := b.CometHeaderByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		BlockHash:       common.Bytes2Hex(header.Header.Hash()),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if res.ErrorCode != 0 {
+		return nil, &rpctypes.SimError{Code: int(res.ErrorCode), Message: res.Error}
+	}
+
+	var results []rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // handleRevertError returns revert related error.
 func handleRevertError(vmError string, ret []byte) error {
 	if len(vmError) > 0 {
@@ -91,6 +91,8 @@ func (b *Backend) getHeightByBlockNum(blockNum rpctypes.BlockNumber) (int64, err
 		// For PendingBlockNumber, we alsoe returns the latest block height.
 		// The reason is that CometBFT does not have the concept of pending block,
 		// and the application state is only updated when a block is committed.
+		// When the EVM mempool is enabled, the pending block, balances, nonces and
+		// calls are instead built from the mempool on top of this height.
 		n, err := b.BlockNumber()
 		if err != nil {
 			return 0, err
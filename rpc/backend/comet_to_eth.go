@@ -14,6 +14,7 @@ import (
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -152,6 +153,13 @@ func (b *Backend) EthBlockFromCometBlock(
 
 	// 4. create blockHeader without transactions, receipts, withdrawals, ...
 	ethHeader := rpctypes.MakeHeader(cmtBlock.Header, gasLimit, miner, baseFee)
+	if b.StateMirror != nil {
+		// the proofs of eth_getProof are verified against the state root
+		// published by the state mirror
+		if root, ok := b.StateMirror.StateRoot(cmtBlock.Height); ok {
+			ethHeader.Root = root
+		}
+	}
 
 	// 5. get MsgEthereumTxs
 	msgs := b.EthMsgsFromCometBlock(resBlock, blockRes)
@@ -167,13 +175,7 @@ func (b *Backend) EthBlockFromCometBlock(
 		Withdrawals:  []*ethtypes.Withdrawal{},
 	}
 
-	// 7. receipts
-	receipts, err := b.ReceiptsFromCometBlock(resBlock, blockRes, msgs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get receipts from comet block: %w", err)
-	}
-
-	// 8. Gas Used
+	// 7. Gas Used
 	gasUsed := uint64(0)
 	for _, txsResult := range blockRes.TxsResults {
 		// workaround for cosmos-sdk bug. https://github.com/cosmos/cosmos-sdk/issues/10832
@@ -185,11 +187,42 @@ func (b *Backend) EthBlockFromCometBlock(
 	}
 	ethHeader.GasUsed = gasUsed
 
-	// 9. create eth block
+	// 8. roots of the transactions and receipts, cached by the indexer
+	roots := b.indexedBlockRoots(cmtBlock.Height)
+	if roots != nil {
+		ethHeader.TxHash = roots.TxRoot
+		ethHeader.ReceiptHash = roots.ReceiptRoot
+		ethHeader.Bloom = roots.Bloom
+		ethHeader.UncleHash = ethtypes.EmptyUncleHash
+		ethHeader.WithdrawalsHash = &ethtypes.EmptyWithdrawalsHash
+		return ethtypes.NewBlockWithHeader(ethHeader).WithBody(*body), nil
+	}
+
+	// 9. receipts
+	receipts, err := b.ReceiptsFromCometBlock(resBlock, blockRes, msgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipts from comet block: %w", err)
+	}
+
+	// 10. create eth block, which computes the roots of the transactions and receipts
 	ethBlock := ethtypes.NewBlock(ethHeader, body, receipts, trie.NewStackTrie(nil))
 	return ethBlock, nil
 }
 
+// indexedBlockRoots returns the roots of the transactions and receipts of a
+// block stored by the indexer, or nil if they are not indexed.
+func (b *Backend) indexedBlockRoots(height int64) *servertypes.BlockRoots {
+	if b.Indexer == nil {
+		return nil
+	}
+	roots, err := b.Indexer.GetBlockRoots(height)
+	if err != nil {
+		b.Logger.Debug("failed to get indexed block roots", "height", height, "error", err.Error())
+		return nil
+	}
+	return roots
+}
+
 func (b *Backend) MinerFromCometBlock(
 	resBlock *cmtrpctypes.ResultBlock,
 ) (common.Address, error) {
@@ -251,13 +284,6 @@ func (b *Backend) ReceiptsFromCometBlock(
 			effectiveGasPrice = ethMsg.Raw.GasFeeCap()
 		}
 
-		var status uint64
-		if txResult.Failed {
-			status = ethtypes.ReceiptStatusFailed
-		} else {
-			status = ethtypes.ReceiptStatusSuccessful
-		}
-
 		contractAddress := common.Address{}
 		if ethMsg.Raw.To() == nil {
 			contractAddress = crypto.CreateAddress(ethMsg.GetSender(), ethMsg.Raw.Nonce())
@@ -273,31 +299,22 @@ func (b *Backend) ReceiptsFromCometBlock(
 			return nil, fmt.Errorf("failed to convert tx result to eth receipt: %w", err)
 		}
 
-		bloom := ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs})
-
-		receipt := &ethtypes.Receipt{
-			// Consensus fields: These fields are defined by the Yellow Paper
-			Type:              ethMsg.Raw.Type(),
-			PostState:         nil,
-			Status:            status, // convert to 1=success, 0=failure
-			CumulativeGasUsed: cumulatedGasUsed,
-			Bloom:             bloom,
-			Logs:              logs,
-
-			// Implementation fields: These fields are added by geth when processing a transaction.
-			TxHash:            ethMsg.Hash(),
-			ContractAddress:   contractAddress,
-			GasUsed:           txResult.GasUsed,
-			EffectiveGasPrice: effectiveGasPrice,
-			BlobGasUsed:       uint64(0),     // TODO: fill this field
-			BlobGasPrice:      big.NewInt(0), // TODO: fill this field
-
-			// Inclusion information: These fields provide information about the inclusion of the
-			// transaction corresponding to this receipt.
-			BlockHash:        blockHash,
-			BlockNumber:      big.NewInt(resBlock.Block.Height),
-			TransactionIndex: uint(txResult.EthTxIndex), // #nosec G115 -- checked for int overflow already
-		}
+		// Consensus fields: These fields are defined by the Yellow Paper
+		receipt := rpctypes.ConsensusReceipt(ethMsg.Raw.Transaction, txResult.Failed, cumulatedGasUsed, logs)
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		receipt.TxHash = ethMsg.Hash()
+		receipt.ContractAddress = contractAddress
+		receipt.GasUsed = txResult.GasUsed
+		receipt.EffectiveGasPrice = effectiveGasPrice
+		receipt.BlobGasUsed = uint64(0)      // TODO: fill this field
+		receipt.BlobGasPrice = big.NewInt(0) // TODO: fill this field
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		receipt.BlockHash = blockHash
+		receipt.BlockNumber = big.NewInt(resBlock.Block.Height)
+		receipt.TransactionIndex = uint(txResult.EthTxIndex) // #nosec G115 -- checked for int overflow already
 
 		receipts[i] = receipt
 	}
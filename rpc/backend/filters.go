@@ -29,6 +29,17 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogBlocks returns the heights of the blocks that may contain logs matching
+// the addresses and topics, using the log index of the evm tx indexer. The
+// boolean result is false if the indexer is disabled or doesn't cover the range.
+// A positive limit stops the lookup after limit+1 heights.
+func (b *Backend) GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	if b.Indexer == nil {
+		return nil, false, nil
+	}
+	return b.Indexer.GetLogBlocks(fromBlock, toBlock, addresses, topics, limit)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -64,22 +64,43 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 // - pulledStates:  number of state entries processed until now
 // - knownStates:   number of known state entries that still need to be pulled
 func (b *Backend) Syncing() (interface{}, error) {
-	status, err := b.ClientCtx.Client.Status(b.Ctx)
+	progress, err := rpctypes.SyncProgress(b.Ctx, b.ClientCtx.Client)
 	if err != nil {
 		return false, err
 	}
-
-	if !status.SyncInfo.CatchingUp {
+	if progress == nil {
 		return false, nil
 	}
+	return progress, nil
+}
 
-	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+// IndexerStatus returns the progress of the custom eth tx indexer compared to
+// the latest block of the node, or nil if the indexer is not enabled.
+func (b *Backend) IndexerStatus() (*rpctypes.IndexerStatus, error) {
+	if b.Indexer == nil {
+		return nil, nil
+	}
+	status, err := b.ClientCtx.Client.Status(b.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexed, err := b.Indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	res := &rpctypes.IndexerStatus{
+		LatestBlock: hexutil.Uint64(latest),                 //nolint:gosec // G115 // won't exceed uint64
+		Lag:         hexutil.Uint64(max(latest-indexed, 0)), //nolint:gosec // G115 // won't exceed uint64
+	}
+	if indexed >= 0 {
+		indexedBlock := hexutil.Uint64(indexed)
+		res.IndexedBlock = &indexedBlock
+	} else {
+		res.Lag = res.LatestBlock
+	}
+	return res, nil
 }
 
 // SetEtherbase sets the etherbase of the miner
@@ -298,7 +319,7 @@ func (b *Backend) GenerateMinGasCoin(gasPrice hexutil.Big, appConf config.Config
 
 // UnprotectedAllowed returns the node configuration value for allowing
 // unprotected transactions (i.e not replay-protected)
-func (b Backend) UnprotectedAllowed() bool {
+func (b *Backend) UnprotectedAllowed() bool {
 	return b.AllowUnprotectedTxs
 }
 
@@ -337,6 +358,11 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.Cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCTraceFilterCap defines the max number of blocks traced by a `trace_filter` query.
+func (b *Backend) RPCTraceFilterCap() int32 {
+	return b.Cfg.JSONRPC.TraceFilterCap
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 func (b *Backend) RPCMinGasPrice() *big.Int {
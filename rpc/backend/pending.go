@@ -0,0 +1,403 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// maxPendingCallTxs is the maximum number of pending transactions executed
+// before a call on the pending block.
+const maxPendingCallTxs = 256
+
+// pendingBlock is the block the node would propose next: the executable
+// transactions of the EVM mempool, executed on top of the latest block.
+type pendingBlock struct {
+	header *ethtypes.Header
+	// txs are the signed transactions of the mempool included in the block
+	txs []*ethtypes.Transaction
+	// senders and calls hold the senders and results of the transactions,
+	// followed by the ones of the extra calls executed on top of the block
+	senders []common.Address
+	calls   []rpctypes.SimCallResult
+}
+
+// pendingCache caches the last pending block executed without extra calls, so
+// that the pending block and balance queries don't execute the mempool again
+// until a new block is committed or the mempool changes.
+type pendingCache struct {
+	mtx    sync.Mutex
+	height uint64
+	// version identifies the transactions of the mempool included in the block
+	version common.Hash
+	block   *pendingBlock
+}
+
+// get returns the cached pending block built on the given height from the
+// given mempool version.
+func (c *pendingCache) get(height uint64, version common.Hash) (*pendingBlock, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.block == nil || c.height != height || c.version != version {
+		return nil, false
+	}
+	return c.block, true
+}
+
+// set caches the pending block built on the given height from the given
+// mempool version.
+func (c *pendingCache) set(height uint64, version common.Hash, block *pendingBlock) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.height, c.version, c.block = height, version, block
+}
+
+// pendingVersion returns the version of the mempool transactions included in
+// the pending block, which changes with any of them.
+func pendingVersion(txs []*ethtypes.Transaction) common.Hash {
+	hashes := make([]byte, 0, len(txs)*common.HashLength)
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// getPending returns the pending block, executing it when the latest block or
+// the mempool changed since it was last executed. The native value transfers
+// of the block are traced.
+func (b *Backend) getPending() (*pendingBlock, error) {
+	txs, head, err := b.pendingTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	height, version := head.Number.Uint64(), pendingVersion(txs)
+	if pending, ok := b.pendingCache.get(height, version); ok {
+		return pending, nil
+	}
+
+	pending, err := b.simulatePending(txs, head, nil, nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	b.pendingCache.set(height, version, pending)
+	return pending, nil
+}
+
+// pendingTxs returns the executable transactions of the EVM mempool fitting in
+// the next block, along with the latest header.
+func (b *Backend) pendingTxs() ([]*ethtypes.Transaction, *ethtypes.Header, error) {
+	if b.Mempool == nil {
+		return nil, nil, errors.New("pending block requires the EVM mempool")
+	}
+
+	txs, err := b.Mempool.PendingEVMTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	return txs, head, nil
+}
+
+// simulatePending executes the pending block made of the given mempool
+// transactions, followed by the given calls, on a branch of the latest state.
+// The calls see the state left by the pending transactions.
+//
+// The block is simulated without validation, so that the calls don't need to
+// pay for gas, as in eth_call. The state overrides are applied before the
+// pending transactions are executed.
+func (b *Backend) simulatePending(
+	txs []*ethtypes.Transaction,
+	head *ethtypes.Header,
+	calls []evmtypes.TransactionArgs,
+	stateOverrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+	traceTransfers bool,
+) (*pendingBlock, error) {
+	signer := ethtypes.LatestSignerForChainID(b.EvmChainID)
+	args := make([]evmtypes.TransactionArgs, 0, len(txs)+len(calls))
+	for _, tx := range txs {
+		from, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover the sender of pending tx %s: %w", tx.Hash().Hex(), err)
+		}
+		args = append(args, pendingTxArgs(tx, from))
+	}
+	args = append(args, calls...)
+
+	if blockOverrides == nil {
+		blockOverrides = new(rpctypes.BlockOverrides)
+	}
+	if blockOverrides.BaseFeePerGas == nil && head.BaseFee != nil {
+		// the base fee is zeroed for simulations without validation
+		blockOverrides.BaseFeePerGas = (*hexutil.Big)(head.BaseFee)
+	}
+
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{
+			BlockOverrides: blockOverrides,
+			StateOverrides: stateOverrides,
+			Calls:          args,
+		}},
+		TraceTransfers: traceTransfers,
+	}
+	results, err := b.simulate(opts, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute the pending block: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("failed to execute the pending block: no block returned")
+	}
+	// gaps in the block numbers are filled with empty blocks, so the pending
+	// block is the last one
+	result := results[len(results)-1]
+
+	// the simulated transactions are unsigned, so the transactions root is
+	// computed again with the signed ones
+	header := ethtypes.CopyHeader(result.Header)
+	header.TxHash = ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil))
+
+	return &pendingBlock{
+		header:  header,
+		txs:     txs,
+		senders: result.Senders,
+		calls:   result.Calls,
+	}, nil
+}
+
+// pendingCallTxs returns the pending transactions executed before a call on
+// the pending block. Since the pending state of the calls is not cached, every
+// call executes them again, so they are capped to the first maxPendingCallTxs
+// transactions whose gas fits in half of the gas cap, the other half being left
+// for the call. The call sees the state left by these transactions only.
+func pendingCallTxs(txs []*ethtypes.Transaction, gasCap uint64) []*ethtypes.Transaction {
+	if len(txs) > maxPendingCallTxs {
+		txs = txs[:maxPendingCallTxs]
+	}
+	if gasCap == 0 {
+		return txs
+	}
+
+	var gas uint64
+	for i, tx := range txs {
+		if gas += tx.Gas(); gas > gasCap/2 {
+			return txs[:i]
+		}
+	}
+	return txs
+}
+
+// pendingCallGasLimit returns the gas limit of the pending block executing a
+// call on top of the given transactions. As in eth_call, the call is not bound
+// by the gas left in the block, so it gets its own gas on top of the gas of the
+// transactions: the gas of the call when set, or the gas cap, or else the gas
+// limit of the block.
+func pendingCallGasLimit(txs []*ethtypes.Transaction, call evmtypes.TransactionArgs, gasCap, blockGasLimit uint64) uint64 {
+	gas := blockGasLimit
+	switch {
+	case call.Gas != nil:
+		gas = uint64(*call.Gas)
+	case gasCap != 0:
+		gas = gasCap
+	}
+	for _, tx := range txs {
+		gas += tx.Gas()
+	}
+	return gas
+}
+
+// getPendingBlock returns the pending block in the format of
+// eth_getBlockByNumber. As in geth, the hash, nonce and miner of the pending
+// block are null.
+func (b *Backend) getPendingBlock(fullTx bool) (map[string]interface{}, error) {
+	pending, err := b.getPending()
+	if err != nil {
+		return nil, err
+	}
+
+	block := ethtypes.NewBlockWithHeader(pending.header).WithBody(ethtypes.Body{Transactions: pending.txs})
+	fields := rpctypes.RPCMarshalHeader(block.Header(), nil)
+	fields["size"] = hexutil.Uint64(block.Size())
+	for _, field := range []string{"hash", "nonce", "miner"} {
+		fields[field] = nil
+	}
+
+	txs := make([]interface{}, len(pending.txs))
+	for i, tx := range pending.txs {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		txs[i] = rpctypes.NewRPCTransaction(tx, common.Hash{}, block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), b.ChainConfig()) //nolint:gosec // G115 // won't exceed uint64
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	return fields, nil
+}
+
+// doPendingCall executes a call on top of the pending block, made of the
+// pending transactions returned by pendingCallTxs.
+func (b *Backend) doPendingCall(
+	args evmtypes.TransactionArgs,
+	overrides, blockOverrides *json.RawMessage,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	var stateOverrides *rpctypes.StateOverride
+	if overrides != nil {
+		if err := json.Unmarshal(*overrides, &stateOverrides); err != nil {
+			return nil, fmt.Errorf("invalid state overrides format: %w", err)
+		}
+	}
+	var headerOverrides *rpctypes.BlockOverrides
+	if blockOverrides != nil {
+		if err := json.Unmarshal(*blockOverrides, &headerOverrides); err != nil {
+			return nil, fmt.Errorf("invalid block overrides format: %w", err)
+		}
+	}
+
+	txs, head, err := b.pendingTxs()
+	if err != nil {
+		return nil, err
+	}
+	txs = pendingCallTxs(txs, b.RPCGasCap())
+	if headerOverrides == nil {
+		headerOverrides = new(rpctypes.BlockOverrides)
+	}
+	if headerOverrides.GasLimit == nil {
+		gasLimit := hexutil.Uint64(pendingCallGasLimit(txs, args, b.RPCGasCap(), head.GasLimit))
+		headerOverrides.GasLimit = &gasLimit
+	}
+
+	pending, err := b.simulatePending(txs, head, []evmtypes.TransactionArgs{args}, stateOverrides, headerOverrides, false)
+	if err != nil {
+		return nil, err
+	}
+
+	call := pending.calls[len(pending.calls)-1]
+	res := &evmtypes.MsgEthereumTxResponse{
+		Ret:     call.ReturnValue,
+		GasUsed: uint64(call.GasUsed),
+	}
+	if call.Error != nil {
+		res.VmError = call.Error.Message
+		if call.Error.Code == rpctypes.ErrCodeReverted {
+			res.VmError = vm.ErrExecutionReverted.Error()
+		}
+	}
+	if err := handleRevertError(res.VmError, res.Ret); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// getPendingBalance returns the balance of an account once the pending block is
+// executed. The native value transfers of the block are traced and applied to
+// the latest balance, along with the fees paid by the account.
+func (b *Backend) getPendingBalance(address common.Address) (*big.Int, error) {
+	res, err := b.QueryClient.Balance(rpctypes.ContextWithHeight(0), &evmtypes.QueryBalanceRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	latest, ok := sdkmath.NewIntFromString(res.Balance)
+	if !ok {
+		return nil, errors.New("invalid balance")
+	}
+
+	pending, err := b.getPending()
+	if err != nil {
+		return nil, err
+	}
+	return pendingBalance(address, latest.BigInt(), pending), nil
+}
+
+// pendingBalance applies the value transfers and the fees of the pending block
+// to the given balance of an account.
+func pendingBalance(address common.Address, balance *big.Int, pending *pendingBlock) *big.Int {
+	balance = new(big.Int).Set(balance)
+	for i, call := range pending.calls {
+		for _, log := range call.Logs {
+			if log.Address != rpctypes.TransferLogAddress || len(log.Topics) != 3 || log.Topics[0] != rpctypes.TransferLogTopic {
+				continue
+			}
+			amount := new(big.Int).SetBytes(log.Data)
+			if common.BytesToAddress(log.Topics[1].Bytes()) == address {
+				balance.Sub(balance, amount)
+			}
+			if common.BytesToAddress(log.Topics[2].Bytes()) == address {
+				balance.Add(balance, amount)
+			}
+		}
+
+		if i >= len(pending.txs) || pending.senders[i] != address {
+			continue
+		}
+		tx := pending.txs[i]
+		gasPrice := tx.GasPrice()
+		if baseFee := pending.header.BaseFee; baseFee != nil {
+			tip, _ := tx.EffectiveGasTip(baseFee)
+			gasPrice = new(big.Int).Add(tip, baseFee)
+		}
+		fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(call.GasUsed)))
+		balance.Sub(balance, fee)
+	}
+	return balance
+}
+
+// pendingTxArgs returns the call arguments executing a pending transaction.
+func pendingTxArgs(tx *ethtypes.Transaction, from common.Address) evmtypes.TransactionArgs {
+	var (
+		gas   = hexutil.Uint64(tx.Gas())
+		nonce = hexutil.Uint64(tx.Nonce())
+		data  = hexutil.Bytes(tx.Data())
+	)
+	args := evmtypes.TransactionArgs{
+		From:  &from,
+		To:    tx.To(),
+		Gas:   &gas,
+		Value: (*hexutil.Big)(tx.Value()),
+		Nonce: &nonce,
+		Input: &data,
+	}
+
+	switch tx.Type() {
+	case ethtypes.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = accessList(tx)
+	default:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = accessList(tx)
+		args.AuthorizationList = tx.SetCodeAuthorizations()
+	}
+	if tx.Protected() {
+		args.ChainID = (*hexutil.Big)(tx.ChainId())
+	}
+	return args
+}
+
+func accessList(tx *ethtypes.Transaction) *ethtypes.AccessList {
+	accessList := tx.AccessList()
+	return &accessList
+}
@@ -0,0 +1,165 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestPendingTxArgs(t *testing.T) {
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chainID := big.NewInt(9001)
+	baseFee := big.NewInt(5)
+	accessList := ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{0x01}}}}
+
+	testCases := []struct {
+		name string
+		tx   *ethtypes.Transaction
+	}{
+		{
+			name: "legacy tx",
+			tx: ethtypes.NewTx(&ethtypes.LegacyTx{
+				Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(5), Data: []byte{0x01},
+			}),
+		},
+		{
+			name: "access list tx",
+			tx: ethtypes.NewTx(&ethtypes.AccessListTx{
+				ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 30000, To: &to, Value: big.NewInt(5), AccessList: accessList,
+			}),
+		},
+		{
+			name: "dynamic fee contract creation",
+			tx: ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(20), Gas: 100000, Data: []byte{0x60, 0x00}, AccessList: accessList,
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := pendingTxArgs(tc.tx, from)
+			require.NoError(t, args.CallDefaults(0, baseFee, chainID))
+
+			// the call is executed like the transaction
+			msg := args.ToMessage(baseFee, false, false)
+			require.Equal(t, from, msg.From)
+			require.Equal(t, tc.tx.To(), msg.To)
+			require.Equal(t, tc.tx.Nonce(), msg.Nonce)
+			require.Equal(t, tc.tx.Gas(), msg.GasLimit)
+			require.Equal(t, tc.tx.Value(), msg.Value)
+			require.Equal(t, tc.tx.Data(), msg.Data)
+			require.Equal(t, tc.tx.GasFeeCap(), msg.GasFeeCap)
+			require.Equal(t, tc.tx.GasTipCap(), msg.GasTipCap)
+			require.Equal(t, tc.tx.AccessList(), msg.AccessList)
+
+			tip, err := tc.tx.EffectiveGasTip(baseFee)
+			require.NoError(t, err)
+			require.Equal(t, new(big.Int).Add(tip, baseFee), msg.GasPrice)
+		})
+	}
+}
+
+func TestPendingBalance(t *testing.T) {
+	account := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	transferLog := func(from, to common.Address, amount int64) *ethtypes.Log {
+		return &ethtypes.Log{
+			Address: rpctypes.TransferLogAddress,
+			Topics:  []common.Hash{rpctypes.TransferLogTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(big.NewInt(amount)).Bytes(),
+		}
+	}
+
+	pending := &pendingBlock{
+		header: &ethtypes.Header{BaseFee: big.NewInt(10)},
+		txs: []*ethtypes.Transaction{
+			// pays a tip of 2 on top of the base fee
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(20), Gas: 21000, To: &other}),
+			ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(15), Gas: 50000, To: &account}),
+		},
+		senders: []common.Address{account, other, account},
+		calls: []rpctypes.SimCallResult{
+			{GasUsed: 21000, Logs: []*ethtypes.Log{transferLog(account, other, 100)}},
+			{GasUsed: 30000, Logs: []*ethtypes.Log{transferLog(other, account, 40), {Address: other}}},
+			// the calls on top of the block don't pay fees
+			{GasUsed: 25000, Logs: []*ethtypes.Log{transferLog(other, account, 1)}},
+		},
+	}
+
+	balance := big.NewInt(1_000_000)
+	got := pendingBalance(account, balance, pending)
+	require.Equal(t, big.NewInt(1_000_000-100-21000*12+40+1), got)
+	require.Equal(t, big.NewInt(1_000_000), balance, "the latest balance is not modified")
+
+	got = pendingBalance(other, balance, pending)
+	require.Equal(t, big.NewInt(1_000_000+100-40-30000*15-1), got)
+}
+
+func TestPendingCache(t *testing.T) {
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	txs := []*ethtypes.Transaction{
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to}),
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(10), Gas: 21000, To: &to}),
+	}
+	version := pendingVersion(txs)
+	require.Equal(t, version, pendingVersion(txs))
+	require.NotEqual(t, version, pendingVersion(txs[:1]))
+	require.NotEqual(t, pendingVersion(nil), pendingVersion(txs[:1]))
+
+	var cache pendingCache
+	_, ok := cache.get(10, version)
+	require.False(t, ok)
+
+	block := &pendingBlock{txs: txs}
+	cache.set(10, version, block)
+	got, ok := cache.get(10, version)
+	require.True(t, ok)
+	require.Same(t, block, got)
+
+	// a new block or a change of the mempool invalidates the cached block
+	_, ok = cache.get(11, version)
+	require.False(t, ok)
+	_, ok = cache.get(10, pendingVersion(txs[:1]))
+	require.False(t, ok)
+}
+
+func TestPendingCallGasLimit(t *testing.T) {
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	txs := []*ethtypes.Transaction{
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to}),
+		ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(10), Gas: 50000, To: &to}),
+	}
+	gas := hexutil.Uint64(30000)
+
+	// the call gets its gas on top of the gas of the pending transactions
+	require.Equal(t, uint64(101000), pendingCallGasLimit(txs, evmtypes.TransactionArgs{Gas: &gas}, 25000000, 100000))
+	require.Equal(t, uint64(25071000), pendingCallGasLimit(txs, evmtypes.TransactionArgs{}, 25000000, 100000))
+	require.Equal(t, uint64(171000), pendingCallGasLimit(txs, evmtypes.TransactionArgs{}, 0, 100000))
+	require.Equal(t, uint64(100000), pendingCallGasLimit(nil, evmtypes.TransactionArgs{}, 0, 100000))
+}
+
+func TestPendingCallTxs(t *testing.T) {
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	txs := make([]*ethtypes.Transaction, maxPendingCallTxs+1)
+	for i := range txs {
+		txs[i] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: uint64(i), GasPrice: big.NewInt(10), Gas: 21000, To: &to})
+	}
+
+	// the transactions are capped in number, and in gas to half of the gas cap
+	require.Len(t, pendingCallTxs(txs, 0), maxPendingCallTxs)
+	require.Len(t, pendingCallTxs(txs, 25000000), maxPendingCallTxs)
+	require.Len(t, pendingCallTxs(txs, 100000), 2)
+	require.Len(t, pendingCallTxs(txs, 84000), 2)
+	require.Empty(t, pendingCallTxs(txs, 40000))
+	require.Len(t, pendingCallTxs(txs[:3], 0), 3)
+}
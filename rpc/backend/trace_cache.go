@@ -0,0 +1,266 @@
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	// traceCacheResultsPrefix prefixes the encoded block traces in the db
+	traceCacheResultsPrefix = []byte{0x01}
+	// traceCacheOrderPrefix prefixes the insertion order of the block traces
+	// in the db, mapping a sequence to the size and key of the traces
+	traceCacheOrderPrefix = []byte{0x02}
+)
+
+// TraceCache caches the trace results of the blocks, keyed by the block hash
+// and the tracer configuration. The blocks are final once committed, so their
+// traces never change. The results can also be stored in a db, which is used as
+// a second level cache surviving restarts.
+//
+// Both levels are bounded by the size in bytes of the encoded traces. The
+// memory evicts the least recently used traces, while the db prunes the oldest
+// stored ones.
+//
+// A nil TraceCache is valid and caches nothing.
+type TraceCache struct {
+	results *lru.SizeConstrainedCache[string, []byte]
+	logger  log.Logger
+
+	// mtx guards the db and its bookkeeping
+	mtx     sync.Mutex
+	db      dbm.DB
+	maxSize uint64
+	size    uint64
+	// first and next are the sequences of the oldest stored traces and of the
+	// next traces to store
+	first, next uint64
+}
+
+// NewTraceCache creates a trace cache keeping up to maxSize bytes of block
+// traces in memory. The db is optional, the block traces are also stored in it
+// up to maxSize bytes when set.
+func NewTraceCache(maxSize uint64, db dbm.DB, logger log.Logger) (*TraceCache, error) {
+	c := &TraceCache{
+		results: lru.NewSizeConstrainedCache[string, []byte](maxSize),
+		logger:  logger,
+		db:      db,
+		maxSize: maxSize,
+	}
+	if db == nil {
+		return c, nil
+	}
+
+	// restore the bookkeeping of the traces stored by the previous runs
+	it, err := dbm.IteratePrefix(db, traceCacheOrderPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for first := true; it.Valid(); it.Next() {
+		seq := binary.BigEndian.Uint64(it.Key()[len(traceCacheOrderPrefix):])
+		if first {
+			c.first = seq
+			first = false
+		}
+		c.next = seq + 1
+		c.size += binary.BigEndian.Uint64(it.Value())
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	return c, c.prune()
+}
+
+// TraceCacheKey returns the cache key of the traces of a block produced with
+// the given trace configuration. The timeout and reexec options are ignored,
+// since they don't change the results of a successful trace.
+func TraceCacheKey(blockHash []byte, config *evmtypes.TraceConfig) (string, error) {
+	cfg := evmtypes.TraceConfig{}
+	if config != nil {
+		cfg = *config
+	}
+	cfg.Timeout = ""
+	cfg.Reexec = 0
+
+	bz, err := json.Marshal(&cfg)
+	if err != nil {
+		return "", err
+	}
+	configHash := sha256.Sum256(bz)
+
+	return hex.EncodeToString(blockHash) + "/" + cfg.Tracer + "/" + hex.EncodeToString(configHash[:]), nil
+}
+
+// Get returns the cached traces of a block, looking them up in the db when
+// they are not in memory.
+func (c *TraceCache) Get(key string) ([]*evmtypes.TxTraceResult, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	bz, ok := c.results.Get(key)
+	if !ok {
+		if bz, ok = c.getStored(key); !ok {
+			return nil, false
+		}
+		c.results.Add(key, bz)
+	}
+
+	var results []*evmtypes.TxTraceResult
+	if err := json.Unmarshal(bz, &results); err != nil {
+		c.logger.Error("failed to decode cached block traces", "key", key, "error", err.Error())
+		return nil, false
+	}
+
+	return results, true
+}
+
+// Add caches the traces of a block. Traces containing a tracer failure, e.g. a
+// timeout, are not cached since they may succeed on a later request, nor are
+// traces larger than the cache.
+func (c *TraceCache) Add(key string, results []*evmtypes.TxTraceResult) {
+	if c == nil {
+		return
+	}
+
+	for _, result := range results {
+		if result == nil || result.Error != "" {
+			return
+		}
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		c.logger.Error("failed to encode block traces", "key", key, "error", err.Error())
+		return
+	}
+	if uint64(len(bz)) > c.maxSize {
+		return
+	}
+
+	c.results.Add(key, bz)
+
+	if err := c.store(key, bz); err != nil {
+		c.logger.Error("failed to store block traces", "key", key, "error", err.Error())
+	}
+}
+
+// Close closes the db of the trace cache.
+func (c *TraceCache) Close() error {
+	if c == nil || c.db == nil {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.db.Close()
+}
+
+// getStored returns the encoded traces stored in the db.
+func (c *TraceCache) getStored(key string) ([]byte, bool) {
+	if c.db == nil {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	bz, err := c.db.Get(resultsKey(key))
+	if err != nil || bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// store stores the encoded traces in the db, pruning the oldest stored traces
+// beyond the size of the cache.
+func (c *TraceCache) store(key string, bz []byte) error {
+	if c.db == nil {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	// the traces of a block never change
+	if ok, err := c.db.Has(resultsKey(key)); err != nil || ok {
+		return err
+	}
+
+	order := make([]byte, 8, 8+len(key))
+	binary.BigEndian.PutUint64(order, uint64(len(bz)))
+	order = append(order, key...)
+
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(resultsKey(key), bz); err != nil {
+		return err
+	}
+	if err := batch.Set(orderKey(c.next), order); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	c.next++
+	c.size += uint64(len(bz))
+
+	return c.prune()
+}
+
+// prune deletes the oldest stored traces until the stored traces fit in the
+// size of the cache. It must be called with the mutex held.
+func (c *TraceCache) prune() error {
+	if c.size <= c.maxSize {
+		return nil
+	}
+
+	batch := c.db.NewBatch()
+	defer batch.Close()
+
+	size, first := c.size, c.first
+	for ; size > c.maxSize && first < c.next; first++ {
+		order, err := c.db.Get(orderKey(first))
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			continue
+		}
+		if err := batch.Delete(resultsKey(string(order[8:]))); err != nil {
+			return err
+		}
+		if err := batch.Delete(orderKey(first)); err != nil {
+			return err
+		}
+		size -= binary.BigEndian.Uint64(order[:8])
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	c.size, c.first = size, first
+
+	return nil
+}
+
+func resultsKey(key string) []byte {
+	return append(append([]byte{}, traceCacheResultsPrefix...), key...)
+}
+
+func orderKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, traceCacheOrderPrefix...), seq)
+}
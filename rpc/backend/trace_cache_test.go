@@ -0,0 +1,146 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+func TestTraceCacheKey(t *testing.T) {
+	blockHash := []byte{0x01, 0x02}
+
+	key, err := TraceCacheKey(blockHash, &evmtypes.TraceConfig{Tracer: "callTracer"})
+	require.NoError(t, err)
+
+	// the timeout and reexec options don't change the key
+	sameKey, err := TraceCacheKey(blockHash, &evmtypes.TraceConfig{Tracer: "callTracer", Timeout: "10s", Reexec: 5})
+	require.NoError(t, err)
+	require.Equal(t, key, sameKey)
+
+	for _, cfg := range []*evmtypes.TraceConfig{
+		{Tracer: "prestateTracer"},
+		{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+		nil,
+	} {
+		otherKey, err := TraceCacheKey(blockHash, cfg)
+		require.NoError(t, err)
+		require.NotEqual(t, key, otherKey)
+	}
+
+	otherKey, err := TraceCacheKey([]byte{0x03}, &evmtypes.TraceConfig{Tracer: "callTracer"})
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+}
+
+func TestTraceCache(t *testing.T) {
+	// the encoded results take 29 bytes, the cache fits one block traces
+	results := []*evmtypes.TxTraceResult{{Result: map[string]interface{}{"gas": "0x5208"}}}
+	const size = 40
+
+	testCases := []struct {
+		name    string
+		results []*evmtypes.TxTraceResult
+		persist bool
+		expHit  bool
+	}{
+		{
+			name:    "in memory",
+			results: results,
+			expHit:  true,
+		},
+		{
+			name:    "persisted",
+			results: results,
+			persist: true,
+			expHit:  true,
+		},
+		{
+			name:    "tracer failure is not cached",
+			results: []*evmtypes.TxTraceResult{{Error: "execution timeout"}},
+			persist: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var db dbm.DB
+			if tc.persist {
+				db = dbm.NewMemDB()
+			}
+			cache, err := NewTraceCache(size, db, log.NewNopLogger())
+			require.NoError(t, err)
+
+			_, ok := cache.Get("key")
+			require.False(t, ok)
+
+			cache.Add("key", tc.results)
+			got, ok := cache.Get("key")
+			require.Equal(t, tc.expHit, ok)
+			if !tc.expHit {
+				return
+			}
+			require.Equal(t, tc.results, got)
+
+			if tc.persist {
+				// the stored traces survive a restart
+				restarted, err := NewTraceCache(size, db, log.NewNopLogger())
+				require.NoError(t, err)
+				got, ok = restarted.Get("key")
+				require.True(t, ok)
+				require.Equal(t, tc.results, got)
+			}
+
+			// the traces are evicted beyond the size of the cache
+			cache.Add("other", results)
+			_, ok = cache.Get("key")
+			require.False(t, ok)
+			_, ok = cache.Get("other")
+			require.True(t, ok)
+		})
+	}
+
+	// a nil trace cache caches nothing
+	var cache *TraceCache
+	cache.Add("key", results)
+	_, ok := cache.Get("key")
+	require.False(t, ok)
+	require.NoError(t, cache.Close())
+}
+
+func TestTraceCachePrune(t *testing.T) {
+	results := []*evmtypes.TxTraceResult{{Result: map[string]interface{}{"gas": "0x5208"}}}
+	db := dbm.NewMemDB()
+
+	// the db fits the traces of three blocks
+	cache, err := NewTraceCache(100, db, log.NewNopLogger())
+	require.NoError(t, err)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		cache.Add(key, results)
+	}
+	for key, stored := range map[string]bool{"a": false, "b": true, "c": true, "d": true} {
+		ok, err := db.Has(resultsKey(key))
+		require.NoError(t, err)
+		require.Equal(t, stored, ok, key)
+	}
+
+	// the stored traces are pruned to the size of the restarted cache
+	_, err = NewTraceCache(60, db, log.NewNopLogger())
+	require.NoError(t, err)
+	for key, stored := range map[string]bool{"b": false, "c": true, "d": true} {
+		ok, err := db.Has(resultsKey(key))
+		require.NoError(t, err)
+		require.Equal(t, stored, ok, key)
+	}
+
+	// traces larger than the cache are not stored
+	cache, err = NewTraceCache(10, dbm.NewMemDB(), log.NewNopLogger())
+	require.NoError(t, err)
+	cache.Add("key", results)
+	_, ok := cache.Get("key")
+	require.False(t, ok)
+}
@@ -13,6 +13,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -49,6 +50,12 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 		return nil, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
 	}
 
+	// trace the whole block once, the traces of the other transactions of the
+	// block are then served from the cache
+	if b.Cfg.JSONRPC.TraceCachePrewarm && b.TraceCache != nil {
+		return b.traceTransactionFromBlock(transaction, blk, config)
+	}
+
 	var predecessors []*evmtypes.MsgEthereumTx
 	for _, txBz := range blk.Block.Txs[:transaction.TxIndex] {
 		tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
@@ -136,6 +143,33 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 	return decodedResult, nil
 }
 
+// traceTransactionFromBlock traces the block of a transaction with TraceBlock,
+// which caches the traces of all the block transactions, and returns the trace
+// of the transaction.
+func (b *Backend) traceTransactionFromBlock(
+	transaction *servertypes.TxResult,
+	blk *tmrpctypes.ResultBlock,
+	config *rpctypes.TraceConfig,
+) (interface{}, error) {
+	results, err := b.TraceBlock(rpctypes.BlockNumber(blk.Block.Height), config, blk)
+	if err != nil {
+		return nil, err
+	}
+
+	// the traces are ordered as the valid ethereum txs of the block
+	index := int(transaction.EthTxIndex)
+	if index < 0 || index >= len(results) {
+		return nil, fmt.Errorf("transaction not included in the traces of block %v", blk.Block.Height)
+	}
+
+	result := results[index]
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+
+	return result.Result, nil
+}
+
 func (b *Backend) convertConfig(config *rpctypes.TraceConfig) *evmtypes.TraceConfig {
 	if config == nil {
 		return &evmtypes.TraceConfig{}
@@ -160,6 +194,15 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	traceConfig := b.convertConfig(config)
+	cacheKey, err := TraceCacheKey(block.BlockID.Hash, traceConfig)
+	if err != nil {
+		return nil, err
+	}
+	if results, ok := b.TraceCache.Get(cacheKey); ok {
+		return results, nil
+	}
+
 	blockRes, err := b.CometBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		b.Logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
@@ -209,7 +252,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
 		Txs:             txsMessages,
-		TraceConfig:     b.convertConfig(config),
+		TraceConfig:     traceConfig,
 		BlockNumber:     block.Block.Height,
 		BlockTime:       block.Block.Time,
 		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
@@ -228,15 +271,18 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
+	b.TraceCache.Add(cacheKey, decodedResults)
+
 	return decodedResults, nil
 }
 
 // TraceCall executes a call with the given arguments and returns the structured logs
-// created during the execution of EVM. It returns them as a JSON object.
+// created during the execution of EVM. It returns them as a JSON object. The state
+// and block overrides of the config are applied before executing the call.
 func (b *Backend) TraceCall(
 	args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	config *rpctypes.TraceConfig,
+	config *rpctypes.TraceCallConfig,
 ) (interface{}, error) {
 	// Marshal tx args
 	bz, err := json.Marshal(&args)
@@ -268,7 +314,20 @@ func (b *Backend) TraceCall(
 	}
 
 	if config != nil {
-		traceCallRequest.TraceConfig = b.convertConfig(config)
+		traceCallRequest.TraceConfig = b.convertConfig(&config.TraceConfig)
+
+		if config.StateOverrides != nil {
+			traceCallRequest.Overrides, err = json.Marshal(config.StateOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if config.BlockOverrides != nil {
+			traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// get the context of provided block
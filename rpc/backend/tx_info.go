@@ -98,12 +98,71 @@ func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransac
 
 // GetTransactionByHashPending find pending tx from mempool
 func (b *Backend) GetTransactionByHashPending(txHash common.Hash) (*rpctypes.RPCTransaction, error) {
+	msg := b.pendingEthMsg(txHash)
+	if msg == nil {
+		return nil, nil
+	}
+
+	// use zero block values since it's not included in a block yet
+	return rpctypes.NewTransactionFromMsg(
+		msg,
+		common.Hash{},
+		uint64(0),
+		uint64(0),
+		uint64(0),
+		nil,
+		b.ChainConfig(),
+	), nil
+}
+
+// EthTransactionByHash returns the Ethereum transaction identified by hash,
+// looking it up in the mempool when it is not included in a block yet. It
+// returns nil if the transaction is not found.
+func (b *Backend) EthTransactionByHash(txHash common.Hash) (*ethtypes.Transaction, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		if msg := b.pendingEthMsg(txHash); msg != nil {
+			return msg.AsTransaction(), nil
+		}
+		return nil, nil
+	}
+
+	block, err := b.CometBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	// the block is not found if its height was pruned
+	if block == nil || block.Block == nil || int(res.TxIndex) >= len(block.Block.Txs) {
+		b.Logger.Debug("tx not found in block", "hash", txHash.Hex(), "height", res.Height)
+		return nil, nil
+	}
+
+	tx, err := b.ClientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	if int(res.MsgIndex) >= len(msgs) {
+		return nil, nil
+	}
+	msg, ok := msgs[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	return msg.AsTransaction(), nil
+}
+
+// pendingEthMsg finds an Ethereum transaction in the mempool, it returns nil
+// if the transaction is not found.
+func (b *Backend) pendingEthMsg(txHash common.Hash) *evmtypes.MsgEthereumTx {
 	hexTx := txHash.Hex()
 	// try to find tx in mempool
 	txs, err := b.PendingTransactions()
 	if err != nil {
 		b.Logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
-		return nil, nil
+		return nil
 	}
 
 	for _, tx := range txs {
@@ -114,21 +173,12 @@ func (b *Backend) GetTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 		}
 
 		if msg.Hash() == txHash {
-			// use zero block values since it's not included in a block yet
-			return rpctypes.NewTransactionFromMsg(
-				msg,
-				common.Hash{},
-				uint64(0),
-				uint64(0),
-				uint64(0),
-				nil,
-				b.ChainConfig(),
-			), nil
+			return msg
 		}
 	}
 
 	b.Logger.Debug("tx not found", "hash", hexTx)
-	return nil, nil
+	return nil
 }
 
 // GetGasUsed returns gasUsed from transaction
@@ -473,7 +523,7 @@ func (b *Backend) createAccessList(
 	for {
 		accessList := prevTracer.AccessList()
 		traceArgs.AccessList = &accessList
-		res, err := b.DoCall(*traceArgs, blockNum, overrides)
+		res, err := b.DoCall(*traceArgs, blockNum, overrides, nil)
 		if err != nil {
 			b.Logger.Error("failed to apply transaction", "error", err)
 			return nil, 0, nil, fmt.Errorf("failed to apply transaction: %v err: %v", traceArgs.ToTransaction(ethtypes.LegacyTxType).Hash(), err)
@@ -574,3 +624,94 @@ func (b *Backend) initAccessListTracer(args evmtypes.TransactionArgs, blockNum r
 	b.Logger.Debug("access list tracer initialized", "tracer", tracer)
 	return tracer, &args, nil
 }
+
+// GetTransactionsByAddress returns a page of the eth txs sent from, received
+// by or creating the given address, using the address indexes of the evm tx
+// indexer. The page size is capped by the logs cap.
+func (b *Backend) GetTransactionsByAddress(address common.Address, args rpctypes.AddressTxArgs) (*rpctypes.AddressTxsResult, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("address index is not available, enable the evm tx indexer")
+	}
+
+	query := servertypes.AddressTxQuery{
+		Address:    address,
+		ToBlock:    -1,
+		Descending: args.Descending,
+		Limit:      int(b.RPCLogsCap()),
+	}
+	for _, role := range args.Roles {
+		switch role {
+		case rpctypes.AddressTxRoleFrom:
+			query.Roles |= servertypes.AddressTxRoleFrom
+		case rpctypes.AddressTxRoleTo:
+			query.Roles |= servertypes.AddressTxRoleTo
+		case rpctypes.AddressTxRoleCreated:
+			query.Roles |= servertypes.AddressTxRoleCreated
+		default:
+			return nil, fmt.Errorf("unknown address role %s", role)
+		}
+	}
+	if args.FromBlock != nil && *args.FromBlock != rpctypes.EthEarliestBlockNumber {
+		if *args.FromBlock < 0 {
+			latest, err := b.BlockNumber()
+			if err != nil {
+				return nil, err
+			}
+			query.FromBlock = int64(latest) //nolint:gosec // G115 // block number won't exceed int64
+		} else {
+			query.FromBlock = args.FromBlock.Int64()
+		}
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		query.ToBlock = args.ToBlock.Int64()
+	}
+	if args.Cursor != nil {
+		query.Cursor = &servertypes.AddressTxCursor{
+			BlockNumber: int64(args.Cursor.BlockNumber),      //nolint:gosec // G115 // block number won't exceed int64
+			TxIndex:     int32(args.Cursor.TransactionIndex), //nolint:gosec // G115 // tx index won't exceed int32
+		}
+	}
+	if args.Limit != nil {
+		if uint64(*args.Limit) > uint64(query.Limit) { //nolint:gosec // G115 // the cap is not negative
+			return nil, fmt.Errorf("page size %d exceeds the maximum %d", *args.Limit, query.Limit)
+		}
+		query.Limit = int(*args.Limit) //nolint:gosec // G115 // checked against the cap
+	}
+
+	page, err := b.Indexer.GetByAddress(query)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpctypes.AddressTxsResult{Transactions: make([]rpctypes.AddressTx, len(page.Txs))}
+	for i, tx := range page.Txs {
+		res.Transactions[i] = rpctypes.AddressTx{
+			Hash:             tx.Hash,
+			BlockNumber:      hexutil.Uint64(tx.BlockNumber), //nolint:gosec // G115 // block number is not negative
+			TransactionIndex: hexutil.Uint64(tx.TxIndex),     //nolint:gosec // G115 // tx index is not negative
+			Roles:            addressTxRoles(tx.Roles),
+		}
+	}
+	if page.Next != nil {
+		res.Next = &rpctypes.AddressTxCursor{
+			BlockNumber:      hexutil.Uint64(page.Next.BlockNumber), //nolint:gosec // G115 // block number is not negative
+			TransactionIndex: hexutil.Uint64(page.Next.TxIndex),     //nolint:gosec // G115 // tx index is not negative
+		}
+	}
+	return res, nil
+}
+
+// addressTxRoles returns the names of the roles of an address in a tx.
+func addressTxRoles(roles servertypes.AddressTxRole) []string {
+	names := make([]string, 0, 3)
+	if roles&servertypes.AddressTxRoleFrom != 0 {
+		names = append(names, rpctypes.AddressTxRoleFrom)
+	}
+	if roles&servertypes.AddressTxRoleTo != 0 {
+		names = append(names, rpctypes.AddressTxRoleTo)
+	}
+	if roles&servertypes.AddressTxRoleCreated != 0 {
+		names = append(names, rpctypes.AddressTxRoleCreated)
+	}
+	return names
+}
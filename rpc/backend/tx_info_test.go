@@ -68,7 +68,7 @@ func setupMockBackend(t *testing.T) *Backend {
 	allowUnprotectedTxs := false
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
 
-	backend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil)
+	backend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil, nil, nil)
 	backend.Cfg.JSONRPC.GasCap = 25000000
 	backend.Cfg.JSONRPC.EVMTimeout = 0
 	backend.Cfg.JSONRPC.AllowInsecureUnlock = true
@@ -431,6 +431,10 @@ func (m *MockIndexer) LastIndexedBlock() (int64, error) {
 	return 0, nil
 }
 
+func (m *MockIndexer) FirstIndexedBlock() (int64, error) {
+	return 0, nil
+}
+
 func (m *MockIndexer) IndexBlock(block *tmtypes.Block, txResults []*abcitypes.ExecTxResult) error {
 	return nil
 }
@@ -446,6 +450,18 @@ func (m *MockIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ser
 	return nil, nil
 }
 
+func (m *MockIndexer) GetByAddress(query servertypes.AddressTxQuery) (*servertypes.AddressTxPage, error) {
+	return &servertypes.AddressTxPage{}, nil
+}
+
+func (m *MockIndexer) GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	return nil, false, nil
+}
+
+func (m *MockIndexer) GetBlockRoots(height int64) (*servertypes.BlockRoots, error) {
+	return nil, nil
+}
+
 func TestReceiptsFromCometBlock(t *testing.T) {
 	backend := setupMockBackend(t)
 	height := int64(100)
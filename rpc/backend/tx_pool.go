@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -8,7 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -171,3 +175,152 @@ func (b *Backend) Status() (map[string]hexutil.Uint, error) {
 		StatusQueued:  hexutil.Uint(queued),  // #nosec G115 -- overflow not a concern for tx counts, as the mempool will limit far before this number is hit. This is taken directly from Geth.
 	}, nil
 }
+
+// CosmosContent returns the Cosmos transactions of the mempool, by signer and sequence.
+func (b *Backend) CosmosContent() (map[string]map[string]*types.RPCCosmosTransaction, error) {
+	content := make(map[string]map[string]*types.RPCCosmosTransaction)
+
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return content, nil
+	}
+
+	txs, err := evmMempool.CosmosContent()
+	if err != nil {
+		return content, err
+	}
+	for _, tx := range txs {
+		signer := tx.Signer.String()
+		if content[signer] == nil {
+			content[signer] = make(map[string]*types.RPCCosmosTransaction)
+		}
+		content[signer][strconv.FormatUint(tx.Sequence, 10)] = newRPCCosmosTransaction(tx)
+	}
+	return content, nil
+}
+
+// CosmosContentFrom returns the Cosmos transactions of the mempool signed by the address, by sequence.
+func (b *Backend) CosmosContentFrom(addr common.Address) (map[string]*types.RPCCosmosTransaction, error) {
+	content := make(map[string]*types.RPCCosmosTransaction)
+
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return content, nil
+	}
+
+	txs, err := evmMempool.CosmosContentFrom(sdk.AccAddress(addr.Bytes()))
+	if err != nil {
+		return content, err
+	}
+	for _, tx := range txs {
+		content[strconv.FormatUint(tx.Sequence, 10)] = newRPCCosmosTransaction(tx)
+	}
+	return content, nil
+}
+
+// CosmosInspect returns the Cosmos transactions of the mempool flattened into an easily inspectable list.
+func (b *Backend) CosmosInspect() (map[string]map[string]string, error) {
+	inspect := make(map[string]map[string]string)
+
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return inspect, nil
+	}
+
+	txs, err := evmMempool.CosmosContent()
+	if err != nil {
+		return inspect, err
+	}
+	for _, tx := range txs {
+		signer := tx.Signer.String()
+		if inspect[signer] == nil {
+			inspect[signer] = make(map[string]string)
+		}
+		inspect[signer][strconv.FormatUint(tx.Sequence, 10)] = tx.String()
+	}
+	return inspect, nil
+}
+
+// CosmosStatus returns the number of Cosmos transactions in the mempool. They are all pending,
+// the Cosmos pool doesn't queue transactions.
+func (b *Backend) CosmosStatus() (map[string]hexutil.Uint, error) {
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return map[string]hexutil.Uint{StatusPending: hexutil.Uint(0)}, nil
+	}
+
+	return map[string]hexutil.Uint{
+		StatusPending: hexutil.Uint(evmMempool.CountCosmosTx()), // #nosec G115 -- overflow not a concern for tx counts, as the mempool will limit far before this number is hit.
+	}, nil
+}
+
+// NextBlock returns the EVM and Cosmos transactions predicted to be selected in the next block.
+func (b *Backend) NextBlock() (*types.NextBlockResult, error) {
+	result := &types.NextBlockResult{Transactions: []types.RPCSelectedTransaction{}}
+
+	// Get the global mempool instance
+	evmMempool := b.Mempool
+	if evmMempool == nil {
+		return result, nil
+	}
+
+	block, err := evmMempool.NextBlock()
+	if err != nil {
+		return nil, err
+	}
+	result.Gas = hexutil.Uint64(block.Gas)
+	result.BlockGasLimit = hexutil.Uint64(block.BlockGasLimit)
+	for _, tx := range block.Txs {
+		selected := types.RPCSelectedTransaction{
+			Gas:          hexutil.Uint64(tx.Gas),
+			EffectiveTip: (*hexutil.Big)(tx.EffectiveTip.ToBig()),
+		}
+		if tx.EVMTx != nil {
+			selected.Hash = tx.EVMTx.Hash().Bytes()
+			selected.EVM = true
+			selected.Sender = tx.From.Hex()
+			selected.Nonce = hexutil.Uint64(tx.EVMTx.Nonce())
+		} else {
+			selected.Hash = tx.CosmosTx.Hash
+			selected.Sender = tx.CosmosTx.Signer.String()
+			selected.Nonce = hexutil.Uint64(tx.CosmosTx.Sequence)
+		}
+		result.Transactions = append(result.Transactions, selected)
+	}
+	return result, nil
+}
+
+// CancelCosmosTransaction removes the Cosmos transaction signed by the address with the sequence from the
+// mempool of the node, along with the following transactions of the signer, and returns the removed transactions.
+func (b *Backend) CancelCosmosTransaction(addr common.Address, sequence hexutil.Uint64) ([]*types.RPCCosmosTransaction, error) {
+	if b.Mempool == nil {
+		return nil, errors.New("cancelling a Cosmos transaction requires the EVM mempool")
+	}
+
+	txs, err := b.Mempool.CancelCosmosTx(sdk.AccAddress(addr.Bytes()), uint64(sequence))
+	if err != nil {
+		return nil, err
+	}
+	cancelled := make([]*types.RPCCosmosTransaction, 0, len(txs))
+	for _, tx := range txs {
+		cancelled = append(cancelled, newRPCCosmosTransaction(tx))
+	}
+	return cancelled, nil
+}
+
+// newRPCCosmosTransaction returns the RPC representation of a Cosmos transaction of the mempool.
+func newRPCCosmosTransaction(tx evmmempool.CosmosTxInfo) *types.RPCCosmosTransaction {
+	return &types.RPCCosmosTransaction{
+		Hash:        tx.Hash,
+		Signer:      tx.Signer.String(),
+		Sequence:    hexutil.Uint64(tx.Sequence),
+		Priority:    (*hexutil.Big)(tx.Priority.BigInt()),
+		GasWanted:   hexutil.Uint64(tx.GasWanted),
+		Fee:         tx.Fee.String(),
+		MsgTypeURLs: tx.MsgTypeURLs,
+	}
+}
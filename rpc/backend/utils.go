@@ -110,6 +110,13 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		}
 	}
 
+	// the EVM mempool also tracks the nonces of the executable transactions it holds
+	if b.Mempool != nil {
+		if poolNonce := b.Mempool.GetTxPool().PoolNonce(accAddr); poolNonce > nonce {
+			nonce = poolNonce
+		}
+	}
+
 	return nonce, nil
 }
 
@@ -311,12 +318,21 @@ func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*eth
 		return nil, err
 	}
 	blockLogs := [][]*ethtypes.Log{}
-	for _, txResult := range blockRes.TxsResults {
+	for i, txResult := range blockRes.TxsResults {
 		logs, err := evmtypes.DecodeTxLogs(txResult.Data, height)
 		if err != nil {
 			return nil, err
 		}
-		blockLogs = append(blockLogs, logs)
+		// the system logs of the Cosmos transactions, indexed by the position
+		// of the transaction in the block
+		systemLogs, err := evmtypes.DecodeSystemLogs(txResult.Events)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range systemLogs {
+			log.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint
+		}
+		blockLogs = append(blockLogs, append(logs, systemLogs...))
 	}
 	return blockLogs, nil
 }
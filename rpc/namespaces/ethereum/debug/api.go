@@ -141,8 +141,9 @@ func (a *API) TraceBlock(tblockRlp hexutil.Bytes, config *rpctypes.TraceConfig)
 
 // TraceCall lets you trace a given eth_call. It collects the structured logs
 // created during the execution of EVM if the given transaction was added on
-// top of the provided block and returns them as a JSON object.
-func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceConfig) (interface{}, error) {
+// top of the provided block and returns them as a JSON object. The state and
+// block overrides of the config are applied before executing the call.
+func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceCall", "args", args, "block number or hash", blockNrOrHash)
 	return a.backend.TraceCall(args, blockNrOrHash, config)
 }
@@ -170,6 +171,57 @@ func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Byt
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawHeader retrieves the RLP-encoded header by block number or hash.
+func (a *API) GetRawHeader(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawHeader", "block number or hash", blockNrOrHash)
+
+	blockNum, err := a.backend.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := a.backend.HeaderByNumber(blockNum)
+	if err != nil || header == nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(header)
+}
+
+// GetRawReceipts retrieves the consensus-encoded receipts of a block by block
+// number or hash.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+
+	receipts, err := a.backend.EthBlockReceipts(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		bz, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = bz
+	}
+	return result, nil
+}
+
+// GetRawTransaction retrieves the binary-encoded transaction by hash. The
+// transaction is looked up in the mempool when it is not included in a block.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+
+	tx, err := a.backend.EthTransactionByHash(hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	return tx.MarshalBinary()
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// headerBackend serves the headers of the blocks up to its latest height.
+type headerBackend struct {
+	backend.EVMBackend
+	latest rpctypes.BlockNumber
+}
+
+func (b *headerBackend) BlockNumberFromComet(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
+	return *blockNrOrHash.BlockNumber, nil
+}
+
+func (b *headerBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	if blockNum > b.latest {
+		return nil, nil
+	}
+	return &ethtypes.Header{Number: big.NewInt(blockNum.Int64())}, nil
+}
+
+func TestGetRawHeader(t *testing.T) {
+	api := &API{logger: log.NewNopLogger(), backend: &headerBackend{latest: 5}}
+
+	blockNum := rpctypes.BlockNumber(5)
+	raw, err := api.GetRawHeader(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.NoError(t, err)
+	var header ethtypes.Header
+	require.NoError(t, rlp.DecodeBytes(raw, &header))
+	require.Equal(t, big.NewInt(5), header.Number)
+
+	// a missing block has no header
+	blockNum = 6
+	raw, err = api.GetRawHeader(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.NoError(t, err)
+	require.Nil(t, raw)
+}
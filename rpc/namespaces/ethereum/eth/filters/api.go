@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 
@@ -29,7 +30,7 @@ var (
 
 // FilterAPI gathers
 type FilterAPI interface {
-	NewPendingTransactionFilter() rpc.ID
+	NewPendingTransactionFilter(fullTx *bool) rpc.ID
 	NewBlockFilter() rpc.ID
 	NewFilter(criteria filters.FilterCriteria) (rpc.ID, error)
 	GetFilterChanges(id rpc.ID) (interface{}, error)
@@ -48,6 +49,7 @@ type Backend interface {
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloomFromCometBlock(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -65,7 +67,8 @@ type filter struct {
 	typ      filters.Type
 	deadline *time.Timer // filter is inactive when deadline triggers
 	crit     filters.FilterCriteria
-	offset   int // offset for stream subscription
+	fullTx   bool // pending transactions are returned in full
+	offset   int  // offset for stream subscription
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -137,13 +140,14 @@ func (api *PublicFilterAPI) timeoutLoop() {
 }
 
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
-// as transactions enter the pending state.
+// as transactions enter the pending state. If fullTx is true, the full transactions
+// added to the txpool of the EVM mempool are returned instead.
 //
 // It is part of the filter package because this filter can be used through the
 // `eth_getFilterChanges` polling method that is also used for log filters.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newPendingTransactionFilter
-func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
+func (api *PublicFilterAPI) NewPendingTransactionFilter(fullTx *bool) rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
@@ -151,11 +155,23 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID("error creating pending tx filter: max limit reached")
 	}
 
+	full := fullTx != nil && *fullTx
+	var offset int
+	if full {
+		txStream := api.events.PendingFullTxStream()
+		if txStream == nil {
+			return rpc.ID("error creating pending tx filter: full transactions require the EVM mempool")
+		}
+		_, offset = txStream.ReadNonBlocking(-1)
+	} else {
+		_, offset = api.events.PendingTxStream().ReadNonBlocking(-1)
+	}
+
 	id := rpc.NewID()
-	_, offset := api.events.PendingTxStream().ReadNonBlocking(-1)
 	api.filters[id] = &filter{
 		typ:      filters.PendingTransactionsSubscription,
 		deadline: time.NewTimer(api.deadline),
+		fullTx:   full,
 		offset:   offset,
 	}
 
@@ -335,6 +351,16 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 
 	switch f.typ {
 	case filters.PendingTransactionsSubscription:
+		if f.fullTx {
+			var txs []*ethtypes.Transaction
+			txs, f.offset = api.events.PendingFullTxStream().ReadAllNonBlocking(f.offset)
+			chainConfig := evmtypes.GetEthChainConfig()
+			rpcTxs := make([]*types.RPCTransaction, len(txs))
+			for i, tx := range txs {
+				rpcTxs[i] = types.NewRPCPendingTransaction(tx, nil, chainConfig)
+			}
+			return rpcTxs, nil
+		}
 		var hashes []common.Hash
 		hashes, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
 		return returnHashes(hashes), nil
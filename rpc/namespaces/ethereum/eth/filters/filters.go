@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -165,12 +166,34 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, errInvalidBlockRange
 	}
 
-	if blockLimit > 0 && to-from > uint64(blockLimit) {
+	// the log index of the evm tx indexer returns the candidate blocks of a
+	// filtered query, the block range cap then applies to the number of
+	// candidate blocks instead of the range
+	unfiltered := len(f.criteria.Addresses) == 0 && !slices.ContainsFunc(f.criteria.Topics, func(topics []common.Hash) bool {
+		return len(topics) > 0
+	})
+	if blockLimit > 0 && unfiltered && to-from > uint64(blockLimit) {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
+	heights, indexed, err := f.backend.GetLogBlocks(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics, int(blockLimit)) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the log index: %w", err)
+	}
+	if indexed && blockLimit > 0 && int64(len(heights)) > blockLimit {
+		return nil, fmt.Errorf("query matches more than %d blocks", blockLimit)
+	}
+	if !indexed {
+		if blockLimit > 0 && to-from > uint64(blockLimit) {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		}
+		heights = make([]int64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, int64(height)) //#nosec G115
+		}
+	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
+	for _, height := range heights {
+		h := height
 		blockRes, err := f.backend.CometBlockResultByNumber(&h)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
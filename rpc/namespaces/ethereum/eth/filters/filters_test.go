@@ -41,6 +41,10 @@ func (m *MockBackend) GetLogsByHeight(i *int64) ([][]*ethtypes.Log, error) {
 	panic("implement me")
 }
 
+func (m *MockBackend) GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	return nil, false, nil
+}
+
 func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
@@ -187,6 +191,7 @@ func TestLogs(t *testing.T) {
 
 func TestFilter(t *testing.T) {
 	logger := log.NewNopLogger()
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
 	testCases := []struct {
 		name         string
 		filter       filters.FilterCriteria
@@ -202,6 +207,48 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "block range cap without log index returns error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100), Addresses: []common.Address{contract}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(200)}, nil)
+				b.EXPECT().GetLogBlocks(int64(1), int64(100), []common.Address{contract}, [][]common.Hash(nil), 50).Return(nil, false, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
+		{
+			name:   "block range cap of an unfiltered query returns error with log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(200)}, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
+		{
+			name:   "log index candidate blocks cap returns error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100), Addresses: []common.Address{contract}},
+			expectations: func(b *filtermocks.Backend) {
+				heights := make([]int64, 51)
+				for i := range heights {
+					heights[i] = int64(i + 1)
+				}
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(200)}, nil)
+				b.EXPECT().GetLogBlocks(int64(1), int64(100), []common.Address{contract}, [][]common.Hash(nil), 50).Return(heights, true, nil)
+			},
+			expErr: "query matches more than 50 blocks",
+		},
+		{
+			name:   "log index only fetches the candidate blocks",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100), Addresses: []common.Address{contract}},
+			expectations: func(b *filtermocks.Backend) {
+				height := int64(7)
+				blockRes := &cmtrpctypes.ResultBlockResults{Height: height}
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(200)}, nil)
+				b.EXPECT().GetLogBlocks(int64(1), int64(100), []common.Address{contract}, [][]common.Hash(nil), 50).Return([]int64{height}, true, nil)
+				b.EXPECT().CometBlockResultByNumber(&height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(blockRes).Return(ethtypes.Bloom{}, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
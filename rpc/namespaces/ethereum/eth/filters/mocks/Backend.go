@@ -314,6 +314,75 @@ func (_c *Backend_GetBlockByNumber_Call) RunAndReturn(run func(rpctypes.BlockNum
 	return _c
 }
 
+// GetLogBlocks provides a mock function with given fields: fromBlock, toBlock, addresses, topics, limit
+func (_m *Backend) GetLogBlocks(fromBlock int64, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error) {
+	ret := _m.Called(fromBlock, toBlock, addresses, topics, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogBlocks")
+	}
+
+	var r0 []int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) ([]int64, bool, error)); ok {
+		return rf(fromBlock, toBlock, addresses, topics, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Address, [][]common.Hash, int) []int64); ok {
+		r0 = rf(fromBlock, toBlock, addresses, topics, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, []common.Address, [][]common.Hash, int) bool); ok {
+		r1 = rf(fromBlock, toBlock, addresses, topics, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(int64, int64, []common.Address, [][]common.Hash, int) error); ok {
+		r2 = rf(fromBlock, toBlock, addresses, topics, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_GetLogBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogBlocks'
+type Backend_GetLogBlocks_Call struct {
+	*mock.Call
+}
+
+// GetLogBlocks is a helper method to define mock.On call
+//   - fromBlock int64
+//   - toBlock int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+//   - limit int
+func (_e *Backend_Expecter) GetLogBlocks(fromBlock interface{}, toBlock interface{}, addresses interface{}, topics interface{}, limit interface{}) *Backend_GetLogBlocks_Call {
+	return &Backend_GetLogBlocks_Call{Call: _e.mock.On("GetLogBlocks", fromBlock, toBlock, addresses, topics, limit)}
+}
+
+func (_c *Backend_GetLogBlocks_Call) Run(run func(fromBlock int64, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int)) *Backend_GetLogBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].([]common.Address), args[3].([][]common.Hash), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *Backend_GetLogBlocks_Call) Return(_a0 []int64, _a1 bool, _a2 error) *Backend_GetLogBlocks_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_GetLogBlocks_Call) RunAndReturn(run func(int64, int64, []common.Address, [][]common.Hash, int) ([]int64, bool, error)) *Backend_GetLogBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLogs provides a mock function with given fields: blockHash
 func (_m *Backend) GetLogs(blockHash common.Hash) ([][]*types.Log, error) {
 	ret := _m.Called(blockHash)
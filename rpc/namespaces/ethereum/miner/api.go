@@ -5,6 +5,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
 
 	"cosmossdk.io/log"
 
@@ -13,20 +14,23 @@ import (
 
 // API is the private miner prefixed set of APIs in the Miner JSON-RPC spec.
 type API struct {
-	ctx     *server.Context
-	logger  log.Logger
-	backend backend.EVMBackend
+	ctx                   *server.Context
+	logger                log.Logger
+	backend               backend.EVMBackend
+	cosmosTxCancelEnabled bool
 }
 
 // NewPrivateAPI creates an instance of the Miner API.
 func NewPrivateAPI(
 	ctx *server.Context,
 	backend backend.EVMBackend,
+	cosmosTxCancelEnabled bool,
 ) *API {
 	return &API{
-		ctx:     ctx,
-		logger:  ctx.Logger.With("api", "miner"),
-		backend: backend,
+		ctx:                   ctx,
+		logger:                ctx.Logger.With("api", "miner"),
+		backend:               backend,
+		cosmosTxCancelEnabled: cosmosTxCancelEnabled,
 	}
 }
 
@@ -41,3 +45,18 @@ func (api *API) SetGasPrice(gasPrice hexutil.Big) bool {
 	api.logger.Info(api.ctx.Viper.ConfigFileUsed())
 	return api.backend.SetGasPrice(gasPrice)
 }
+
+// CancelCosmosTransaction removes the Cosmos transaction signed by the address with the sequence from the
+// mempool of the node, along with the following transactions of the signer. The other nodes may still
+// include the transactions they received.
+//
+// The cancellation isn't signed by the signer of the transactions, so it is an operator action, disabled
+// unless json-rpc.enable-cosmos-tx-cancel is set. The signers replace their transactions with a new one
+// at the same sequence instead.
+func (api *API) CancelCosmosTransaction(address common.Address, sequence hexutil.Uint64) ([]*types.RPCCosmosTransaction, error) {
+	api.logger.Debug("miner_cancelCosmosTransaction", "address", address, "sequence", sequence)
+	if !api.cosmosTxCancelEnabled {
+		return nil, types.ErrCosmosTxCancelDisabled
+	}
+	return api.backend.CancelCosmosTransaction(address, sequence)
+}
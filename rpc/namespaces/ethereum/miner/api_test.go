@@ -0,0 +1,42 @@
+package miner
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// cancelBackend records the Cosmos transactions it cancels.
+type cancelBackend struct {
+	backend.EVMBackend
+	cancelled []hexutil.Uint64
+}
+
+func (b *cancelBackend) CancelCosmosTransaction(_ common.Address, sequence hexutil.Uint64) ([]*types.RPCCosmosTransaction, error) {
+	b.cancelled = append(b.cancelled, sequence)
+	return []*types.RPCCosmosTransaction{{Sequence: sequence}}, nil
+}
+
+func TestCancelCosmosTransaction(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	b := &cancelBackend{}
+
+	// the cancellation is disabled by default
+	api := &API{logger: log.NewNopLogger(), backend: b}
+	_, err := api.CancelCosmosTransaction(address, 5)
+	require.ErrorIs(t, err, types.ErrCosmosTxCancelDisabled)
+	require.Empty(t, b.cancelled)
+
+	api.cosmosTxCancelEnabled = true
+	cancelled, err := api.CancelCosmosTransaction(address, 5)
+	require.NoError(t, err)
+	require.Len(t, cancelled, 1)
+	require.Equal(t, []hexutil.Uint64{5}, b.cancelled)
+}
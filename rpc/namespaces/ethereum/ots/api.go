@@ -0,0 +1,438 @@
+package ots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// APILevel is the Otterscan API level implemented by the ots namespace.
+const APILevel = 8
+
+const callTracer = "callTracer"
+
+// Types of the internal operations returned by ots_getInternalOperations.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// Backend defines the methods required by the ots API backend
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	CometBlockByNumber(blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(height *int64) (*cmtrpctypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(block *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *cmtrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+}
+
+// API is the Otterscan JSON-RPC API.
+type API struct {
+	logger  log.Logger
+	backend Backend
+	indexer servertypes.EVMTxIndexer
+}
+
+// NewAPI creates a new Otterscan API instance.
+func NewAPI(ctx *server.Context, backend Backend, indexer servertypes.EVMTxIndexer) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+		indexer: indexer,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (api *API) GetApiLevel() uint64 { //nolint:revive,staticcheck // method name is part of the ots api
+	api.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// GetInternalOperations returns the value transfers, self-destructs and
+// contract creations performed by the internal calls of a transaction.
+func (api *API) GetInternalOperations(hash common.Hash) ([]*InternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+
+	frame, err := api.traceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	ops := []*InternalOperation{}
+	for i := range frame.Calls {
+		ops = internalOperations(&frame.Calls[i], ops)
+	}
+	return ops, nil
+}
+
+// TraceTransaction returns the call tree of a transaction, as a flat list of
+// calls with their depth.
+func (api *API) TraceTransaction(hash common.Hash) ([]*TraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+
+	frame, err := api.traceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	return traceEntries(frame, 0, []*TraceEntry{}), nil
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce, or nil if there is none. The nonce may
+// also have been used by a Cosmos transaction.
+func (api *API) GetTransactionBySenderAndNonce(address common.Address, nonce hexutil.Uint64) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// search for the first block after which the account nonce exceeds the given one
+	height, found, err := api.searchBlock(uint64(latest), func(height uint64) (bool, error) {
+		count, err := api.backend.GetTransactionCount(address, rpctypes.BlockNumber(height)) //nolint:gosec // G115 // height won't exceed int64
+		if err != nil {
+			return false, err
+		}
+		return uint64(*count) > uint64(nonce), nil
+	})
+	if err != nil || !found {
+		return nil, err
+	}
+
+	msgs, _, err := api.blockMsgs(height)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if msg.GetSender() == address && msg.AsTransaction().Nonce() == uint64(nonce) {
+			txHash := msg.Hash()
+			return &txHash, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetContractCreator returns the transaction that created the contract at
+// the given address and its sender, or nil if the address is not a contract.
+// Contracts created by internal calls are found by tracing the block in which
+// the contract code was deployed.
+func (api *API) GetContractCreator(address common.Address) (*ContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", address)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	hasCode := func(height uint64) (bool, error) {
+		blockNr := rpctypes.BlockNumber(height) //nolint:gosec // G115 // height won't exceed int64
+		code, err := api.backend.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		if err != nil {
+			return false, err
+		}
+		return len(code) > 0, nil
+	}
+	height, found, err := api.searchBlock(uint64(latest), hasCode)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	msgs, block, err := api.blockMsgs(height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(block.Block.Height), &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	}, block)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if result.Error != "" || i >= len(msgs) {
+			continue
+		}
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		if creator := findCreator(frame, address); creator != nil {
+			return &ContractCreator{Hash: msgs[i].Hash(), Creator: *creator}, nil
+		}
+	}
+	return nil, nil
+}
+
+// SearchTransactionsBefore returns the transactions involving the address
+// before the given block, in descending order. A block number of 0 searches
+// from the latest block. The transactions of a block are never split across
+// pages, so a page may hold more than pageSize transactions.
+func (api *API) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", address, "block number", blockNumber, "page size", pageSize)
+
+	query := servertypes.AddressTxQuery{
+		Address:    address,
+		ToBlock:    int64(blockNumber) - 1, //nolint:gosec // G115 // block number won't exceed int64
+		Descending: true,
+		Limit:      int(pageSize),
+	}
+	isFirstPage := blockNumber == 0
+	if isFirstPage {
+		// search from the latest block
+		query.ToBlock = -1
+	}
+	hashes, hasMore, err := api.searchAddress(query)
+	if err != nil {
+		return nil, err
+	}
+	return api.transactionsWithReceipts(hashes, isFirstPage, !hasMore)
+}
+
+// SearchTransactionsAfter returns the transactions involving the address
+// after the given block, in descending order. A block number of 0 searches
+// from the genesis block. The transactions of a block are never split across
+// pages, so a page may hold more than pageSize transactions.
+func (api *API) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", address, "block number", blockNumber, "page size", pageSize)
+
+	hashes, hasMore, err := api.searchAddress(servertypes.AddressTxQuery{
+		Address:   address,
+		FromBlock: int64(blockNumber) + 1, //nolint:gosec // G115 // block number won't exceed int64
+		ToBlock:   -1,
+		Limit:     int(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the results are always in descending order
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+	return api.transactionsWithReceipts(hashes, !hasMore, blockNumber == 0)
+}
+
+// GetBlockDetails returns the header of a block with its transaction count,
+// issuance and fees.
+func (api *API) GetBlockDetails(blockNr rpctypes.BlockNumber) (*BlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetails", "number", blockNr)
+
+	block, err := api.backend.GetBlockByNumber(blockNr, false)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, nil
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	totalFees := new(big.Int)
+	for _, tx := range txs {
+		txHash, ok := tx.(common.Hash)
+		if !ok {
+			continue
+		}
+		receipt, err := api.backend.GetTransactionReceipt(txHash)
+		if err != nil || receipt == nil {
+			continue
+		}
+		gasUsed, _ := receipt["gasUsed"].(hexutil.Uint64)
+		gasPrice, _ := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if gasPrice == nil {
+			continue
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()))
+	}
+
+	delete(block, "transactions")
+	block["transactionCount"] = len(txs)
+	// the bloom filter is not used by Otterscan
+	block["logsBloom"] = nil
+
+	return &BlockDetails{
+		Block: block,
+		Issuance: Issuance{
+			BlockReward: new(hexutil.Big),
+			UncleReward: new(hexutil.Big),
+			Issuance:    new(hexutil.Big),
+		},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// searchAddress returns the hashes of a page of the txs involving an address
+// and whether more txs are available. The page is extended to the end of its
+// last block, as Otterscan requests the next page by block number.
+func (api *API) searchAddress(query servertypes.AddressTxQuery) ([]common.Hash, bool, error) {
+	if api.indexer == nil {
+		return nil, false, errors.New("address index disabled, enable the evm tx indexer with json-rpc.enable-indexer and json-rpc.enable-address-index")
+	}
+	page, err := api.indexer.GetByAddress(query)
+	if err != nil {
+		return nil, false, err
+	}
+	txs := page.Txs
+	hasMore := page.Next != nil
+
+	if hasMore && len(txs) > 0 && page.Next.BlockNumber == txs[len(txs)-1].BlockNumber {
+		last := page.Next.BlockNumber
+		rest := query
+		rest.FromBlock, rest.ToBlock = last, last
+		rest.Cursor = page.Next
+		rest.Limit = 0
+		restPage, err := api.indexer.GetByAddress(rest)
+		if err != nil {
+			return nil, false, err
+		}
+		txs = append(txs, restPage.Txs...)
+
+		// check for txs beyond the last block
+		next := query
+		next.Limit = 1
+		if query.Descending {
+			next.ToBlock = last - 1
+		} else {
+			next.FromBlock = last + 1
+		}
+		hasMore = false
+		if !query.Descending || last > 0 {
+			nextPage, err := api.indexer.GetByAddress(next)
+			if err != nil {
+				return nil, false, err
+			}
+			hasMore = len(nextPage.Txs) > 0
+		}
+	}
+
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash
+	}
+	return hashes, hasMore, nil
+}
+
+// transactionsWithReceipts loads the transactions and receipts of the given hashes.
+func (api *API) transactionsWithReceipts(hashes []common.Hash, isFirstPage, isLastPage bool) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:       make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts:  make([]map[string]interface{}, 0, len(hashes)),
+		FirstPage: isFirstPage,
+		LastPage:  isLastPage,
+	}
+	timestamps := make(map[uint64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := api.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := api.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			continue
+		}
+
+		// Otterscan expects the block timestamp in the receipt
+		blockNumber := tx.BlockNumber.ToInt().Uint64()
+		timestamp, ok := timestamps[blockNumber]
+		if !ok {
+			block, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(blockNumber)) //nolint:gosec // G115 // block number won't exceed int64
+			if err != nil {
+				return nil, err
+			}
+			if block == nil || block.Block == nil {
+				return nil, fmt.Errorf("block %d not found", blockNumber)
+			}
+			timestamp = hexutil.Uint64(block.Block.Time.Unix()) //nolint:gosec // G115 // timestamp won't exceed uint64
+			timestamps[blockNumber] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
+
+// traceTransaction traces a transaction with the callTracer.
+func (api *API) traceTransaction(hash common.Hash) (*callFrame, error) {
+	res, err := api.backend.TraceTransaction(hash, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeCallFrame(res)
+}
+
+// blockMsgs returns the Ethereum transactions of the block at the given height.
+func (api *API) blockMsgs(height uint64) ([]*evmtypes.MsgEthereumTx, *cmtrpctypes.ResultBlock, error) {
+	block, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(height)) //nolint:gosec // G115 // height won't exceed int64
+	if err != nil {
+		return nil, nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, nil, errors.New("block not found")
+	}
+	blockRes, err := api.backend.CometBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return api.backend.EthMsgsFromCometBlock(block, blockRes), block, nil
+}
+
+// searchBlock returns the first block height in [1, latest] for which the
+// monotonic condition holds, using a binary search.
+func (api *API) searchBlock(latest uint64, cond func(height uint64) (bool, error)) (uint64, bool, error) {
+	ok, err := cond(latest)
+	if err != nil || !ok {
+		return 0, false, err
+	}
+	lo, hi := uint64(1), latest
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		ok, err := cond(mid)
+		if err != nil {
+			return 0, false, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, true, nil
+}
+
+// decodeCallFrame decodes the result of the callTracer.
+func decodeCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	frame := new(callFrame)
+	if err := json.Unmarshal(bz, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
@@ -0,0 +1,148 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// InternalOperation is a value transfer, self-destruct or contract creation
+// performed by an internal call of a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a single call of the call tree returned by ots_traceTransaction.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// ContractCreator is the transaction that created a contract and its sender.
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// TransactionsWithReceipts is a page of the transaction history of an address.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// BlockDetails is the result of ots_getBlockDetails.
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  Issuance               `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// Issuance holds the block rewards, which are always zero as rewards are
+// distributed by the Cosmos SDK modules.
+type Issuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// callFrame is the output of the go-ethereum native callTracer.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to,omitempty"`
+	Value  *hexutil.Big    `json:"value,omitempty"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	Calls  []callFrame     `json:"calls,omitempty"`
+}
+
+func (f *callFrame) to() common.Address {
+	if f.To == nil {
+		return common.Address{}
+	}
+	return *f.To
+}
+
+func (f *callFrame) value() *hexutil.Big {
+	if f.Value == nil {
+		return new(hexutil.Big)
+	}
+	return f.Value
+}
+
+// internalOperations appends the operations of a call frame and its sub calls.
+// Frames that failed, and all their sub calls, are reverted and skipped.
+func internalOperations(frame *callFrame, ops []*InternalOperation) []*InternalOperation {
+	if frame.Error != "" {
+		return ops
+	}
+
+	op := &InternalOperation{From: frame.From, To: frame.to(), Value: frame.value()}
+	switch frame.Type {
+	case "CALL":
+		if frame.value().ToInt().Sign() > 0 {
+			op.Type = OpTransfer
+			ops = append(ops, op)
+		}
+	case "SELFDESTRUCT":
+		op.Type = OpSelfDestruct
+		ops = append(ops, op)
+	case "CREATE":
+		op.Type = OpCreate
+		ops = append(ops, op)
+	case "CREATE2":
+		op.Type = OpCreate2
+		ops = append(ops, op)
+	}
+
+	for i := range frame.Calls {
+		ops = internalOperations(&frame.Calls[i], ops)
+	}
+	return ops
+}
+
+// traceEntries flattens a call frame and its sub calls, in depth-first order.
+func traceEntries(frame *callFrame, depth int, entries []*TraceEntry) []*TraceEntry {
+	entries = append(entries, &TraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		To:     frame.to(),
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	})
+	for i := range frame.Calls {
+		entries = traceEntries(&frame.Calls[i], depth+1, entries)
+	}
+	return entries
+}
+
+// findCreator returns the sender of the successful call frame that created
+// the contract at the given address, if any.
+func findCreator(frame *callFrame, address common.Address) *common.Address {
+	if frame.Error != "" {
+		return nil
+	}
+	if (frame.Type == "CREATE" || frame.Type == "CREATE2") && frame.to() == address {
+		return &frame.From
+	}
+	for i := range frame.Calls {
+		if creator := findCreator(&frame.Calls[i], address); creator != nil {
+			return creator
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package ots
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		created  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		callee   = common.HexToAddress("0x4000000000000000000000000000000000000004")
+		reverted = common.HexToAddress("0x5000000000000000000000000000000000000005")
+	)
+
+	frame := &callFrame{
+		Type:  "CALL",
+		From:  sender,
+		To:    &contract,
+		Value: (*hexutil.Big)(big.NewInt(10)),
+		Calls: []callFrame{
+			{
+				Type: "CREATE2",
+				From: contract,
+				To:   &created,
+				Calls: []callFrame{
+					{Type: "CALL", From: created, To: &callee, Value: (*hexutil.Big)(big.NewInt(1))},
+					{Type: "STATICCALL", From: created, To: &callee},
+				},
+			},
+			{
+				Type:  "CREATE",
+				From:  contract,
+				To:    &reverted,
+				Error: "execution reverted",
+				Calls: []callFrame{
+					{Type: "CALL", From: reverted, To: &callee, Value: (*hexutil.Big)(big.NewInt(2))},
+				},
+			},
+			{Type: "SELFDESTRUCT", From: contract, To: &sender, Value: (*hexutil.Big)(big.NewInt(5))},
+		},
+	}
+
+	ops := []*InternalOperation{}
+	for i := range frame.Calls {
+		ops = internalOperations(&frame.Calls[i], ops)
+	}
+	require.Len(t, ops, 3)
+	require.Equal(t, OpCreate2, ops[0].Type)
+	require.Equal(t, created, ops[0].To)
+	require.Equal(t, OpTransfer, ops[1].Type)
+	require.Equal(t, big.NewInt(1), ops[1].Value.ToInt())
+	require.Equal(t, OpSelfDestruct, ops[2].Type)
+	require.Equal(t, sender, ops[2].To)
+
+	entries := traceEntries(frame, 0, []*TraceEntry{})
+	require.Len(t, entries, 7)
+	require.Equal(t, "CALL", entries[0].Type)
+	require.Equal(t, 0, entries[0].Depth)
+	require.Equal(t, "CREATE2", entries[1].Type)
+	require.Equal(t, 1, entries[1].Depth)
+	require.Equal(t, "STATICCALL", entries[3].Type)
+	require.Equal(t, 2, entries[3].Depth)
+	require.Equal(t, "SELFDESTRUCT", entries[6].Type)
+	require.Equal(t, 1, entries[6].Depth)
+
+	require.Equal(t, &contract, findCreator(frame, created))
+	require.Nil(t, findCreator(frame, reverted))
+	require.Nil(t, findCreator(frame, callee))
+}
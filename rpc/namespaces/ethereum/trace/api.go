@@ -0,0 +1,429 @@
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	callTracer     = "callTracer"
+	prestateTracer = "prestateTracer"
+
+	// addressPageSize is the page size of the address index lookups of trace_filter
+	addressPageSize = 1000
+)
+
+// Backend defines the methods required by the trace API backend
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	BlockNumberFromComet(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error)
+	CometBlockByNumber(blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(height *int64) (*cmtrpctypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(block *cmtrpctypes.ResultBlock, blockRes *cmtrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetTxByEthHash(txHash common.Hash) (*servertypes.TxResult, error)
+	GetTxByTxIndex(height int64, txIndex uint) (*servertypes.TxResult, error)
+	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *cmtrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	RPCBlockRangeCap() int32
+	RPCTraceFilterCap() int32
+}
+
+// API is the parity (OpenEthereum) compatible trace API. The call traces are
+// produced by the go-ethereum native callTracer and flattened.
+type API struct {
+	logger  log.Logger
+	backend Backend
+	indexer servertypes.EVMTxIndexer
+}
+
+// NewAPI creates a new trace API instance. The indexer, if not nil, narrows
+// the blocks traced by trace_filter with its address indexes.
+func NewAPI(ctx *server.Context, backend Backend, indexer servertypes.EVMTxIndexer) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+		indexer: indexer,
+	}
+}
+
+// Block returns the traces of all the transactions of the given block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*Trace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	block, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	return api.blockTraces(block)
+}
+
+// Transaction returns the traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	txResult, err := api.backend.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(txResult.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	res, err := api.backend.TraceTransaction(hash, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+	frame, err := decodeResult[callFrame](res)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := flatten(frame, []int{}, nil)
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	setLocation(traces, blockHash, uint64(txResult.Height), hash, uint64(txResult.EthTxIndex)) //nolint:gosec // G115 // height and index are not negative
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types for each of them. The supported trace
+// types are "trace" and "stateDiff".
+func (api *API) ReplayBlockTransactions(blockNrOrHash rpctypes.BlockNumberOrHash, traceTypes []string) ([]*TraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "block number or hash", blockNrOrHash, "trace types", traceTypes)
+
+	if slices.Contains(traceTypes, ReplayTypeVMTrace) {
+		return nil, fmt.Errorf("trace type %s is not supported", ReplayTypeVMTrace)
+	}
+
+	blockNr, err := api.backend.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	block, err := api.backend.CometBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	msgs, err := api.blockMsgs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the output of the transactions is taken from the call traces
+	calls, err := api.traceBlock(block, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TraceResults, len(calls))
+	for i, res := range calls {
+		frame, err := decodeResult[callFrame](res)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = &TraceResults{
+			Output:          frame.Output,
+			TransactionHash: msgs[i].Hash(),
+		}
+		if slices.Contains(traceTypes, ReplayTypeTrace) {
+			results[i].Trace = flatten(frame, []int{}, nil)
+		}
+	}
+
+	if slices.Contains(traceTypes, ReplayTypeStateDiff) {
+		diffs, err := api.traceBlock(block, &rpctypes.TraceConfig{
+			TraceConfig:  evmtypes.TraceConfig{Tracer: prestateTracer},
+			TracerConfig: json.RawMessage(`{"diffMode":true}`),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i, res := range diffs {
+			diff, err := decodeResult[prestateDiff](res)
+			if err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = stateDiff(diff)
+		}
+	}
+	return results, nil
+}
+
+// Filter returns the traces of the given block range that match the address
+// filters. Blocks without Ethereum transactions in the indexer are skipped.
+//
+// Ranges within the trace-filter-cap are traced block by block, so that the
+// internal calls of the filter addresses are matched too. Larger ranges, up to
+// the block-range-cap, are only served when address filters are set and the
+// address indexes are enabled: only the blocks with transactions sent from,
+// received by or creating one of the filter addresses are then traced, and
+// the internal calls of the filter addresses are only found in the
+// transactions that involve them at the top level.
+func (api *API) Filter(args FilterArgs) ([]*Trace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := uint64(1), uint64(latest)
+	if args.FromBlock != nil {
+		from = resolveBlockNumber(*args.FromBlock, uint64(latest))
+	}
+	if args.ToBlock != nil {
+		to = resolveBlockNumber(*args.ToBlock, uint64(latest))
+	}
+	if from == 0 {
+		// the genesis block is not traceable
+		from = 1
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d > to %d", from, to)
+	}
+	if blockLimit := uint64(api.backend.RPCBlockRangeCap()); blockLimit > 0 && to-from > blockLimit { //nolint:gosec // G115 // cap is not negative
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var heights []uint64
+	traceLimit := uint64(api.backend.RPCTraceFilterCap()) //nolint:gosec // G115 // cap is not negative
+	if traceLimit == 0 || to-from <= traceLimit {
+		// the address indexes only record the top-level sender and recipient
+		// of the transactions, trace every block to match the internal calls
+		heights = make([]uint64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, height)
+		}
+	} else {
+		var indexed bool
+		heights, indexed, err = api.addressBlocks(append(slices.Clone(args.FromAddress), args.ToAddress...), from, to, traceLimit)
+		if err != nil {
+			return nil, err
+		}
+		if !indexed {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance without the address index: %d", traceLimit)
+		}
+	}
+
+	var (
+		traces  []*Trace
+		after   uint64
+		matched uint64
+	)
+	if args.After != nil {
+		after = *args.After
+	}
+	for _, height := range heights {
+		if args.Count != nil && uint64(len(traces)) >= *args.Count {
+			break
+		}
+		if res, err := api.backend.GetTxByTxIndex(int64(height), 0); err != nil || res == nil { //nolint:gosec // G115 // height won't exceed int64
+			// no ethereum transaction in the block
+			continue
+		}
+
+		block, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(height)) //nolint:gosec // G115 // height won't exceed int64
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || block.Block == nil {
+			continue
+		}
+		blockTraces, err := api.blockTraces(block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !matchAddresses(trace, args.FromAddress, args.ToAddress) {
+				continue
+			}
+			matched++
+			if matched <= after {
+				continue
+			}
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				break
+			}
+			traces = append(traces, trace)
+		}
+	}
+	return traces, nil
+}
+
+// addressBlocks returns the ascending heights of the blocks in [from, to] with
+// transactions involving any of the addresses, from the address indexes. It
+// fails if there are more than limit blocks. The boolean result is false if
+// no address is given or the address indexes are not enabled.
+func (api *API) addressBlocks(addresses []common.Address, from, to, limit uint64) ([]uint64, bool, error) {
+	if len(addresses) == 0 || api.indexer == nil {
+		return nil, false, nil
+	}
+
+	blocks := make(map[uint64]struct{})
+	for _, address := range addresses {
+		query := servertypes.AddressTxQuery{
+			Address:   address,
+			FromBlock: int64(from), //nolint:gosec // G115 // block number won't exceed int64
+			ToBlock:   int64(to),   //nolint:gosec // G115 // block number won't exceed int64
+			Limit:     addressPageSize,
+		}
+		for {
+			page, err := api.indexer.GetByAddress(query)
+			if errors.Is(err, indexer.ErrAddressIndexDisabled) {
+				return nil, false, nil
+			}
+			if err != nil {
+				return nil, false, err
+			}
+			for _, tx := range page.Txs {
+				blocks[uint64(tx.BlockNumber)] = struct{}{} //nolint:gosec // G115 // block number is not negative
+			}
+			if limit > 0 && uint64(len(blocks)) > limit {
+				return nil, false, fmt.Errorf("the address filters match more than %d blocks, narrow the block range", limit)
+			}
+			if page.Next == nil {
+				break
+			}
+			query.Cursor = page.Next
+		}
+	}
+
+	heights := make([]uint64, 0, len(blocks))
+	for height := range blocks {
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+	return heights, true, nil
+}
+
+// blockTraces traces all the Ethereum transactions of a block and returns the
+// flattened traces with their location.
+func (api *API) blockTraces(block *cmtrpctypes.ResultBlock) ([]*Trace, error) {
+	msgs, err := api.blockMsgs(block)
+	if err != nil {
+		return nil, err
+	}
+	results, err := api.traceBlock(block, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	traces := make([]*Trace, 0, len(results))
+	for i, res := range results {
+		frame, err := decodeResult[callFrame](res)
+		if err != nil {
+			return nil, err
+		}
+		txTraces := flatten(frame, []int{}, nil)
+		setLocation(txTraces, blockHash, uint64(block.Block.Height), msgs[i].Hash(), uint64(i)) //nolint:gosec // G115 // height is not negative
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// blockMsgs returns the Ethereum transactions of a block, in the order they
+// are traced.
+func (api *API) blockMsgs(block *cmtrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, error) {
+	blockRes, err := api.backend.CometBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.EthMsgsFromCometBlock(block, blockRes), nil
+}
+
+// traceBlock traces the transactions of a block with the given tracer. A
+// failure to trace any of the transactions is returned as an error.
+func (api *API) traceBlock(block *cmtrpctypes.ResultBlock, config *rpctypes.TraceConfig) ([]interface{}, error) {
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]interface{}, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d of block %d: %s", i, block.Block.Height, result.Error)
+		}
+		res[i] = result.Result
+	}
+	return res, nil
+}
+
+// decodeResult decodes the generic result of a tracer into the given type.
+func decodeResult[T any](result interface{}) (*T, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	res := new(T)
+	if err := json.Unmarshal(bz, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// setLocation sets the block and transaction of the traces of a transaction.
+func setLocation(traces []*Trace, blockHash common.Hash, blockNumber uint64, txHash common.Hash, txIndex uint64) {
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txIndex
+	}
+}
+
+// matchAddresses returns true if the trace sender is in fromAddresses and its
+// recipient in toAddresses. An empty list matches all addresses.
+func matchAddresses(trace *Trace, fromAddresses, toAddresses []common.Address) bool {
+	from, to := actionAddresses(trace)
+	if len(fromAddresses) > 0 && !slices.Contains(fromAddresses, from) {
+		return false
+	}
+	if len(toAddresses) > 0 && !slices.Contains(toAddresses, to) {
+		return false
+	}
+	return true
+}
+
+// resolveBlockNumber converts the block number tags into the block height.
+func resolveBlockNumber(blockNr rpctypes.BlockNumber, latest uint64) uint64 {
+	if blockNr < 0 {
+		return latest
+	}
+	return uint64(blockNr) //nolint:gosec // G115 // checked not negative
+}
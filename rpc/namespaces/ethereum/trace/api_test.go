@@ -0,0 +1,160 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+// addressIndexer serves the address lookups from a list of txs per address,
+// one tx per page.
+type addressIndexer struct {
+	servertypes.EVMTxIndexer
+	txs      map[common.Address][]servertypes.AddressTx
+	disabled bool
+}
+
+func (idx *addressIndexer) GetByAddress(query servertypes.AddressTxQuery) (*servertypes.AddressTxPage, error) {
+	if idx.disabled {
+		return nil, indexer.ErrAddressIndexDisabled
+	}
+	var txs []servertypes.AddressTx
+	for _, tx := range idx.txs[query.Address] {
+		if tx.BlockNumber < query.FromBlock || tx.BlockNumber > query.ToBlock {
+			continue
+		}
+		if query.Cursor != nil && tx.BlockNumber < query.Cursor.BlockNumber {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	page := &servertypes.AddressTxPage{}
+	if len(txs) > 0 {
+		page.Txs = txs[:1]
+	}
+	if len(txs) > 1 {
+		page.Next = &servertypes.AddressTxCursor{BlockNumber: txs[1].BlockNumber}
+	}
+	return page, nil
+}
+
+func TestAddressBlocks(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		bob   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	)
+	idx := &addressIndexer{txs: map[common.Address][]servertypes.AddressTx{
+		alice: {{BlockNumber: 3}, {BlockNumber: 7}, {BlockNumber: 12}},
+		bob:   {{BlockNumber: 7}, {BlockNumber: 9}},
+	}}
+	api := &API{indexer: idx}
+
+	heights, indexed, err := api.addressBlocks([]common.Address{alice, bob}, 1, 10, 10)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	require.Equal(t, []uint64{3, 7, 9}, heights)
+
+	_, _, err = api.addressBlocks([]common.Address{alice, bob}, 1, 10, 2)
+	require.ErrorContains(t, err, "match more than 2 blocks")
+
+	_, indexed, err = api.addressBlocks(nil, 1, 10, 10)
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	idx.disabled = true
+	_, indexed, err = api.addressBlocks([]common.Address{alice}, 1, 10, 10)
+	require.NoError(t, err)
+	require.False(t, indexed)
+}
+
+// filterBackend serves one Ethereum transaction per block, traced as the
+// given call frame.
+type filterBackend struct {
+	Backend
+	latest uint64
+	frame  callFrame
+}
+
+func (b *filterBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.latest), nil
+}
+
+func (b *filterBackend) GetTxByTxIndex(int64, uint) (*servertypes.TxResult, error) {
+	return &servertypes.TxResult{}, nil
+}
+
+func (b *filterBackend) CometBlockByNumber(blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, error) {
+	return &cmtrpctypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: blockNum.Int64()}}}, nil
+}
+
+func (b *filterBackend) CometBlockResultByNumber(*int64) (*cmtrpctypes.ResultBlockResults, error) {
+	return &cmtrpctypes.ResultBlockResults{}, nil
+}
+
+func (b *filterBackend) EthMsgsFromCometBlock(*cmtrpctypes.ResultBlock, *cmtrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx {
+	return []*evmtypes.MsgEthereumTx{evmtypes.NewTx(&evmtypes.EvmTxArgs{To: b.frame.To})}
+}
+
+func (b *filterBackend) TraceBlock(rpctypes.BlockNumber, *rpctypes.TraceConfig, *cmtrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+	return []*evmtypes.TxTraceResult{{Result: b.frame}}, nil
+}
+
+func (b *filterBackend) RPCBlockRangeCap() int32  { return 10000 }
+func (b *filterBackend) RPCTraceFilterCap() int32 { return 100 }
+
+func TestFilterInternalCalls(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		caller = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		callee = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	)
+	// the sender calls a contract, which calls another contract
+	backend := &filterBackend{
+		latest: 1000,
+		frame: callFrame{
+			Type:  "CALL",
+			From:  sender,
+			To:    &caller,
+			Calls: []callFrame{{Type: "CALL", From: caller, To: &callee}},
+		},
+	}
+	// the address index only knows the top-level sender and recipient
+	idx := &addressIndexer{txs: map[common.Address][]servertypes.AddressTx{
+		sender: {{BlockNumber: 500}},
+		caller: {{BlockNumber: 500}},
+	}}
+	api := &API{logger: log.NewNopLogger(), backend: backend, indexer: idx}
+
+	from, to := rpctypes.BlockNumber(500), rpctypes.BlockNumber(501)
+	traces, err := api.Filter(FilterArgs{FromBlock: &from, ToBlock: &to, ToAddress: []common.Address{callee}})
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	for i, trace := range traces {
+		require.Equal(t, callee, trace.Action.(*CallAction).To)
+		require.Equal(t, []int{0}, trace.TraceAddress)
+		require.Equal(t, uint64(500+i), *trace.BlockNumber)
+	}
+
+	// ranges beyond the trace-filter-cap are narrowed with the address index
+	from, to = rpctypes.BlockNumber(1), rpctypes.BlockNumber(1000)
+	traces, err = api.Filter(FilterArgs{FromBlock: &from, ToBlock: &to, ToAddress: []common.Address{caller}})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, uint64(500), *traces[0].BlockNumber)
+
+	idx.disabled = true
+	_, err = api.Filter(FilterArgs{FromBlock: &from, ToBlock: &to, ToAddress: []common.Address{caller}})
+	require.ErrorContains(t, err, "without the address index")
+}
@@ -0,0 +1,314 @@
+package trace
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Trace types of the parity flat trace format.
+const (
+	TypeCall    = "call"
+	TypeCreate  = "create"
+	TypeSuicide = "suicide"
+)
+
+// Trace types that can be requested from trace_replayBlockTransactions.
+const (
+	ReplayTypeTrace     = "trace"
+	ReplayTypeStateDiff = "stateDiff"
+	ReplayTypeVMTrace   = "vmTrace"
+)
+
+// Trace is a single call frame in the parity (OpenEthereum) flat trace format.
+// The block and transaction fields are omitted by trace_replayBlockTransactions.
+type Trace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// CallAction is the action of a message call trace.
+type CallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// CreateAction is the action of a contract creation trace.
+type CreateAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// SuicideAction is the action of a self-destruct trace.
+type SuicideAction struct {
+	Address       common.Address `json:"address"`
+	RefundAddress common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big   `json:"balance"`
+}
+
+// CallResult is the result of a successful message call trace.
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateResult is the result of a successful contract creation trace.
+type CreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// TraceResults is the replay result of a single transaction returned by
+// trace_replayBlockTransactions.
+type TraceResults struct {
+	Output          hexutil.Bytes                   `json:"output"`
+	StateDiff       map[common.Address]*AccountDiff `json:"stateDiff"`
+	Trace           []*Trace                        `json:"trace"`
+	VMTrace         interface{}                     `json:"vmTrace"`
+	TransactionHash common.Hash                     `json:"transactionHash"`
+}
+
+// AccountDiff is the state difference of a single account. Each field is
+// either the string "=" when unchanged, or an object keyed by "+" (created),
+// "-" (deleted) or "*" (modified).
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// callFrame is the output of the go-ethereum native callTracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+// prestateAccount is an account of the go-ethereum native prestateTracer.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// prestateDiff is the output of the prestateTracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// flatten converts a callTracer frame and its sub calls into flat traces, in
+// depth-first order.
+func flatten(frame *callFrame, traceAddress []int, traces []*Trace) []*Trace {
+	trace := &Trace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	var to common.Address
+	if frame.To != nil {
+		to = *frame.To
+	}
+
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		trace.Type = TypeCreate
+		trace.Action = &CreateAction{From: frame.From, Gas: frame.Gas, Init: frame.Input, Value: value}
+		trace.Result = &CreateResult{Address: to, Code: frame.Output, GasUsed: frame.GasUsed}
+	case "SELFDESTRUCT":
+		trace.Type = TypeSuicide
+		trace.Action = &SuicideAction{Address: frame.From, RefundAddress: to, Balance: value}
+	default:
+		trace.Type = TypeCall
+		trace.Action = &CallAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     frame.From,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			To:       to,
+			Value:    value,
+		}
+		trace.Result = &CallResult{GasUsed: frame.GasUsed, Output: frame.Output}
+	}
+	if frame.Error != "" {
+		trace.Error = parityError(frame.Error)
+		trace.Result = nil
+	}
+
+	traces = append(traces, trace)
+	for i := range frame.Calls {
+		subAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		traces = flatten(&frame.Calls[i], append(subAddress, i), traces)
+	}
+	return traces
+}
+
+// parityError converts a go-ethereum vm error into its parity equivalent.
+func parityError(err string) string {
+	switch err {
+	case "execution reverted":
+		return "Reverted"
+	case "out of gas":
+		return "Out of gas"
+	case "invalid jump destination":
+		return "Bad jump destination"
+	case "stack underflow":
+		return "Stack underflow"
+	default:
+		return err
+	}
+}
+
+// actionAddresses returns the sender and recipient of a trace, as matched by
+// the address filters of trace_filter.
+func actionAddresses(trace *Trace) (from, to common.Address) {
+	switch action := trace.Action.(type) {
+	case *CallAction:
+		return action.From, action.To
+	case *CreateAction:
+		if result, ok := trace.Result.(*CreateResult); ok {
+			to = result.Address
+		}
+		return action.From, to
+	case *SuicideAction:
+		return action.Address, action.RefundAddress
+	}
+	return from, to
+}
+
+// stateDiff converts the output of the prestateTracer in diff mode into the
+// parity state diff format.
+func stateDiff(diff *prestateDiff) map[common.Address]*AccountDiff {
+	res := make(map[common.Address]*AccountDiff)
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			res[addr] = accountDiff(pre, nil)
+			continue
+		}
+		res[addr] = accountDiff(pre, post)
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			res[addr] = accountDiff(nil, post)
+		}
+	}
+	return res
+}
+
+// accountDiff returns the state difference of an account that was created
+// (pre is nil), deleted (post is nil) or modified.
+func accountDiff(pre, post *prestateAccount) *AccountDiff {
+	switch {
+	case pre == nil:
+		diff := &AccountDiff{
+			Balance: born(balanceOf(post)),
+			Code:    born(post.Code),
+			Nonce:   born(hexutil.Uint64(post.Nonce)),
+			Storage: make(map[common.Hash]interface{}, len(post.Storage)),
+		}
+		for key, val := range post.Storage {
+			diff.Storage[key] = born(val)
+		}
+		return diff
+	case post == nil:
+		diff := &AccountDiff{
+			Balance: died(balanceOf(pre)),
+			Code:    died(pre.Code),
+			Nonce:   died(hexutil.Uint64(pre.Nonce)),
+			Storage: make(map[common.Hash]interface{}, len(pre.Storage)),
+		}
+		for key, val := range pre.Storage {
+			diff.Storage[key] = died(val)
+		}
+		return diff
+	}
+
+	// only the modified fields are part of the post state
+	diff := &AccountDiff{
+		Balance: "=",
+		Code:    "=",
+		Nonce:   "=",
+		Storage: make(map[common.Hash]interface{}),
+	}
+	if post.Balance != nil {
+		diff.Balance = changed(balanceOf(pre), post.Balance)
+	}
+	if post.Code != nil {
+		diff.Code = changed(pre.Code, post.Code)
+	}
+	if post.Nonce != 0 {
+		diff.Nonce = changed(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+	}
+	for key, val := range post.Storage {
+		diff.Storage[key] = changed(pre.Storage[key], val)
+	}
+	// storage slots cleared by the transaction are only part of the pre state
+	for key, val := range pre.Storage {
+		if _, ok := post.Storage[key]; !ok {
+			diff.Storage[key] = changed(val, common.Hash{})
+		}
+	}
+	return diff
+}
+
+func balanceOf(account *prestateAccount) *hexutil.Big {
+	if account.Balance == nil {
+		return new(hexutil.Big)
+	}
+	return account.Balance
+}
+
+func born(val interface{}) map[string]interface{} {
+	return map[string]interface{}{"+": val}
+}
+
+func died(val interface{}) map[string]interface{} {
+	return map[string]interface{}{"-": val}
+}
+
+func changed(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
@@ -0,0 +1,125 @@
+package trace
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatten(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		created  = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		callee   = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	)
+
+	frame := &callFrame{
+		Type:    "CALL",
+		From:    sender,
+		To:      &contract,
+		Value:   (*hexutil.Big)(big.NewInt(10)),
+		Gas:     100000,
+		GasUsed: 50000,
+		Input:   hexutil.Bytes{0x01},
+		Output:  hexutil.Bytes{0x02},
+		Calls: []callFrame{
+			{
+				Type:    "CREATE2",
+				From:    contract,
+				To:      &created,
+				Gas:     40000,
+				GasUsed: 30000,
+				Input:   hexutil.Bytes{0x60},
+				Output:  hexutil.Bytes{0x61},
+				Calls: []callFrame{
+					{Type: "STATICCALL", From: created, To: &callee, Error: "execution reverted"},
+				},
+			},
+			{Type: "SELFDESTRUCT", From: contract, To: &sender, Value: (*hexutil.Big)(big.NewInt(5))},
+		},
+	}
+
+	traces := flatten(frame, []int{}, nil)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, TypeCall, traces[0].Type)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, "call", traces[0].Action.(*CallAction).CallType)
+	require.Equal(t, hexutil.Bytes{0x02}, traces[0].Result.(*CallResult).Output)
+
+	require.Equal(t, TypeCreate, traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, created, traces[1].Result.(*CreateResult).Address)
+	require.Equal(t, hexutil.Bytes{0x60}, traces[1].Action.(*CreateAction).Init)
+
+	require.Equal(t, TypeCall, traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, "staticcall", traces[2].Action.(*CallAction).CallType)
+	require.Equal(t, "Reverted", traces[2].Error)
+	require.Nil(t, traces[2].Result)
+
+	require.Equal(t, TypeSuicide, traces[3].Type)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, sender, traces[3].Action.(*SuicideAction).RefundAddress)
+
+	from, to := actionAddresses(traces[1])
+	require.Equal(t, contract, from)
+	require.Equal(t, created, to)
+	require.True(t, matchAddresses(traces[1], nil, []common.Address{created}))
+	require.False(t, matchAddresses(traces[1], []common.Address{sender}, nil))
+}
+
+func TestStateDiff(t *testing.T) {
+	var (
+		modified = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		created  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		slot     = common.HexToHash("0x01")
+		cleared  = common.HexToHash("0x02")
+	)
+
+	var diff prestateDiff
+	err := json.Unmarshal([]byte(`{
+		"pre": {
+			"0x1000000000000000000000000000000000000001": {
+				"balance": "0x10",
+				"nonce": 1,
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000001",
+					"0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000002"
+				}
+			}
+		},
+		"post": {
+			"0x1000000000000000000000000000000000000001": {
+				"balance": "0x5",
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000003"
+				}
+			},
+			"0x2000000000000000000000000000000000000002": {
+				"balance": "0x1",
+				"code": "0x6000"
+			}
+		}
+	}`), &diff)
+	require.NoError(t, err)
+
+	res := stateDiff(&diff)
+	require.Len(t, res, 2)
+
+	require.Equal(t, changed((*hexutil.Big)(big.NewInt(16)), (*hexutil.Big)(big.NewInt(5))), res[modified].Balance)
+	require.Equal(t, "=", res[modified].Nonce)
+	require.Equal(t, "=", res[modified].Code)
+	require.Equal(t, changed(common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(3))), res[modified].Storage[slot])
+	require.Equal(t, changed(common.BigToHash(big.NewInt(2)), common.Hash{}), res[modified].Storage[cleared])
+
+	require.Equal(t, born((*hexutil.Big)(big.NewInt(1))), res[created].Balance)
+	require.Equal(t, born(hexutil.Bytes{0x60, 0x00}), res[created].Code)
+	require.Equal(t, born(hexutil.Uint64(0)), res[created].Nonce)
+}
@@ -48,3 +48,33 @@ func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
 	return api.backend.Status()
 }
+
+// CosmosContent returns the Cosmos transactions contained within the mempool
+func (api *PublicAPI) CosmosContent() (map[string]map[string]*types.RPCCosmosTransaction, error) {
+	api.logger.Debug("txpool_cosmosContent")
+	return api.backend.CosmosContent()
+}
+
+// CosmosContentFrom returns the Cosmos transactions contained within the mempool signed by an address
+func (api *PublicAPI) CosmosContentFrom(address common.Address) (map[string]*types.RPCCosmosTransaction, error) {
+	api.logger.Debug("txpool_cosmosContentFrom")
+	return api.backend.CosmosContentFrom(address)
+}
+
+// CosmosInspect returns the Cosmos transactions of the mempool and flattens them into an easily inspectable list
+func (api *PublicAPI) CosmosInspect() (map[string]map[string]string, error) {
+	api.logger.Debug("txpool_cosmosInspect")
+	return api.backend.CosmosInspect()
+}
+
+// CosmosStatus returns the number of Cosmos transactions in the mempool
+func (api *PublicAPI) CosmosStatus() (map[string]hexutil.Uint, error) {
+	api.logger.Debug("txpool_cosmosStatus")
+	return api.backend.CosmosStatus()
+}
+
+// NextBlock returns the EVM and Cosmos transactions predicted to be selected in the next block
+func (api *PublicAPI) NextBlock() (*types.NextBlockResult, error) {
+	api.logger.Debug("txpool_nextBlock")
+	return api.backend.NextBlock()
+}
@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -50,6 +52,12 @@ type RPCHeader struct {
 	Hash      common.Hash
 }
 
+// TxPoolFeed is implemented by the EVM mempool txpool, it feeds the
+// transactions as they are added to the pool.
+type TxPoolFeed interface {
+	SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs bool) event.Subscription
+}
+
 // RPCStream provides data streams for newHeads, logs, and pendingTransactions.
 type RPCStream struct {
 	evtClient rpcclient.EventsClient
@@ -63,6 +71,11 @@ type RPCStream struct {
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
 
+	// pendingFullTxStream is backed by the txpool of the EVM mempool, it's nil
+	// if the node doesn't run the EVM mempool
+	pendingFullTxStream *Stream[*ethtypes.Transaction]
+	txPoolSub           event.Subscription
+
 	wg sync.WaitGroup
 }
 
@@ -102,7 +115,36 @@ func (s *RPCStream) initSubscriptions() {
 	go s.start(&s.wg, chBlocks, chLogs)
 }
 
+// ListenTxPool feeds the full pending transaction stream with the
+// transactions added to the txpool.
+func (s *RPCStream) ListenTxPool(feed TxPoolFeed) {
+	if s.pendingFullTxStream != nil {
+		// already listening
+		return
+	}
+
+	s.pendingFullTxStream = NewStream[*ethtypes.Transaction](txStreamSegmentSize, txStreamCapacity)
+	ch := make(chan core.NewTxsEvent, subscribBufferSize)
+	s.txPoolSub = feed.SubscribeTransactions(ch, false)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case ev := <-ch:
+				s.pendingFullTxStream.Add(ev.Txs...)
+			case <-s.txPoolSub.Err():
+				return
+			}
+		}
+	}()
+}
+
 func (s *RPCStream) Close() error {
+	if s.txPoolSub != nil {
+		s.txPoolSub.Unsubscribe()
+	}
 	if s.headerStream == nil {
 		// not initialized
 		return nil
@@ -124,6 +166,12 @@ func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
 	return s.pendingTxStream
 }
 
+// PendingFullTxStream returns the stream of the transactions added to the
+// txpool, or nil if the node doesn't run the EVM mempool.
+func (s *RPCStream) PendingFullTxStream() *Stream[*ethtypes.Transaction] {
+	return s.pendingFullTxStream
+}
+
 func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	s.initSubscriptions()
 	return s.logStream
@@ -3,3 +3,5 @@ package types
 import "errors"
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+var ErrCosmosTxCancelDisabled = errors.New("cancellation of Cosmos transactions disabled in the miner namespace")
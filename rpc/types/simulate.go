@@ -0,0 +1,130 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Error codes returned by eth_simulateV1, as defined in go-ethereum's internal/ethapi/errors.go.
+const (
+	ErrCodeNonceTooHigh          = -38011
+	ErrCodeNonceTooLow           = -38010
+	ErrCodeIntrinsicGas          = -38013
+	ErrCodeInsufficientFunds     = -38014
+	ErrCodeBlockGasLimitReached  = -38015
+	ErrCodeBlockNumberInvalid    = -38020
+	ErrCodeBlockTimestampInvalid = -38021
+	ErrCodeClientLimitExceeded   = -38026
+	ErrCodeInternalError         = -32603
+	ErrCodeInvalidParams         = -32602
+	ErrCodeReverted              = -32000
+	ErrCodeVMError               = -32015
+)
+
+var (
+	// TransferLogAddress is the address of the ERC-7528 logs emitted for native
+	// value transfers when transfer tracing is enabled.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// TransferLogTopic is the event signature of ERC-20 `Transfer(address,address,uint256)`.
+	TransferLogTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimError is an error that aborts an eth_simulateV1 request. It implements the
+// go-ethereum rpc.Error interface, so the client receives its error code.
+type SimError struct {
+	Code    int
+	Message string
+}
+
+func (e *SimError) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *SimError) ErrorCode() int {
+	return e.Code
+}
+
+// BlockOverrides is a set of header fields to override when executing calls.
+//
+// This type refers to go-ethereum v1.16.3 internal package type - override.BlockOverrides
+// (https://github.com/ethereum/go-ethereum/blob/d818a9af7bd5919808df78f31580f59382c53150/internal/ethapi/override/override.go)
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Apply overrides the given header fields into the block context.
+func (o *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		blockCtx.BlockNumber = o.Number.ToInt()
+	}
+	if o.Time != nil {
+		blockCtx.Time = uint64(*o.Time)
+	}
+	if o.GasLimit != nil {
+		blockCtx.GasLimit = uint64(*o.GasLimit)
+	}
+	if o.FeeRecipient != nil {
+		blockCtx.Coinbase = *o.FeeRecipient
+	}
+	if o.PrevRandao != nil {
+		blockCtx.Random = o.PrevRandao
+	}
+	if o.BaseFeePerGas != nil {
+		blockCtx.BaseFee = o.BaseFeePerGas.ToInt()
+	}
+}
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// same block, with optional header and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is a simulated block as produced by the EVM module. The
+// transactions are unsigned, so their senders are returned alongside.
+type SimBlockResult struct {
+	Header       *ethtypes.Header        `json:"header"`
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	Senders      []common.Address        `json:"senders"`
+	Calls        []SimCallResult         `json:"calls"`
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtrpccore "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// consensusStateClient is implemented by the CometBFT clients exposing the
+// consensus state of the peers.
+type consensusStateClient interface {
+	DumpConsensusState(context.Context) (*cmtrpccore.ResultDumpConsensusState, error)
+}
+
+// peerState is the part of the consensus state of a peer holding its height.
+type peerState struct {
+	RoundState struct {
+		Height int64 `json:"height,string"`
+	} `json:"round_state"`
+}
+
+// SyncProgress returns the sync progress of the node, or nil if the node is not
+// catching up with its peers. The result has the fields of eth_syncing:
+// - startingBlock: earliest block stored by this node
+// - currentBlock:  latest block committed by this node
+// - highestBlock:  highest block committed by the peers of this node
+func SyncProgress(ctx context.Context, cometClient client.CometRPC) (map[string]interface{}, error) {
+	status, err := cometClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !status.SyncInfo.CatchingUp {
+		return nil, nil
+	}
+
+	latest := status.SyncInfo.LatestBlockHeight
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),        //nolint:gosec // G115 // won't exceed uint64
+		"currentBlock":  hexutil.Uint64(latest),                                     //nolint:gosec // G115 // won't exceed uint64
+		"highestBlock":  hexutil.Uint64(HighestPeerBlock(ctx, cometClient, latest)), //nolint:gosec // G115 // won't exceed uint64
+	}, nil
+}
+
+// HighestPeerBlock returns the highest block committed by the peers of the
+// node, or the latest block of the node if it is higher or if the consensus
+// state of the peers is not available. A peer at a consensus height has
+// committed the previous block.
+func HighestPeerBlock(ctx context.Context, cometClient client.CometRPC, latest int64) int64 {
+	highest := latest
+	csClient, ok := cometClient.(consensusStateClient)
+	if !ok {
+		return highest
+	}
+	res, err := csClient.DumpConsensusState(ctx)
+	if err != nil || res == nil {
+		return highest
+	}
+	for _, peer := range res.Peers {
+		var state peerState
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		highest = max(highest, state.RoundState.Height-1)
+	}
+	return highest
+}
@@ -189,3 +189,96 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// TraceCallConfig is the config of debug_traceCall, which extends TraceConfig
+// with the state and block overrides applied to the traced call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// Roles of an address in the txs returned by eth_getTransactionsByAddress.
+const (
+	AddressTxRoleFrom    = "from"
+	AddressTxRoleTo      = "to"
+	AddressTxRoleCreated = "created"
+)
+
+// AddressTxArgs are the arguments of eth_getTransactionsByAddress.
+type AddressTxArgs struct {
+	FromBlock *BlockNumber `json:"fromBlock"`
+	ToBlock   *BlockNumber `json:"toBlock"`
+	// Roles filters the txs by the roles of the address, empty matches any role.
+	Roles []string `json:"roles"`
+	// Descending returns the most recent txs first.
+	Descending bool `json:"descending"`
+	// Cursor is the position of the first tx of the page, as returned by the previous page.
+	Cursor *AddressTxCursor `json:"cursor"`
+	Limit  *hexutil.Uint64  `json:"limit"`
+}
+
+// AddressTxCursor is the position of a transaction in the address indexes.
+type AddressTxCursor struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+}
+
+// AddressTx is a transaction involving an address.
+type AddressTx struct {
+	Hash             common.Hash    `json:"hash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Roles            []string       `json:"roles"`
+}
+
+// IndexerStatus is the progress of the custom eth tx indexer.
+type IndexerStatus struct {
+	// LatestBlock is the latest block committed by the node.
+	LatestBlock hexutil.Uint64 `json:"latestBlock"`
+	// IndexedBlock is the latest indexed block, nil if no block is indexed.
+	IndexedBlock *hexutil.Uint64 `json:"indexedBlock"`
+	// Lag is the number of blocks the indexer is behind the latest block.
+	Lag hexutil.Uint64 `json:"lag"`
+}
+
+// AddressTxsResult is a page of the transactions involving an address.
+type AddressTxsResult struct {
+	Transactions []AddressTx `json:"transactions"`
+	// Next is the cursor of the next page, nil on the last page.
+	Next *AddressTxCursor `json:"next"`
+}
+
+// RPCCosmosTransaction is a Cosmos transaction held in the mempool.
+type RPCCosmosTransaction struct {
+	Hash     hexutil.Bytes  `json:"hash"`
+	Signer   string         `json:"signer"`
+	Sequence hexutil.Uint64 `json:"sequence"`
+	// Priority is the priority of the transaction in the Cosmos pool.
+	Priority    *hexutil.Big   `json:"priority"`
+	GasWanted   hexutil.Uint64 `json:"gasWanted"`
+	Fee         string         `json:"fee"`
+	MsgTypeURLs []string       `json:"msgTypeUrls"`
+}
+
+// RPCSelectedTransaction is a transaction predicted to be selected in the next block.
+type RPCSelectedTransaction struct {
+	// Hash is the Ethereum hash of an EVM transaction or the CometBFT hash of a Cosmos transaction.
+	Hash hexutil.Bytes `json:"hash"`
+	EVM  bool          `json:"evm"`
+	// Sender is the hex address of the sender of an EVM transaction or the bech32 address of the
+	// signer of a Cosmos transaction.
+	Sender string `json:"sender"`
+	// Nonce is the nonce of an EVM transaction or the sequence of the signer of a Cosmos transaction.
+	Nonce        hexutil.Uint64 `json:"nonce"`
+	Gas          hexutil.Uint64 `json:"gas"`
+	EffectiveTip *hexutil.Big   `json:"effectiveTip"`
+}
+
+// NextBlockResult is the predicted selection of the transactions of the next block.
+type NextBlockResult struct {
+	Transactions []RPCSelectedTransaction `json:"transactions"`
+	// Gas is the total gas limit of the selected transactions.
+	Gas           hexutil.Uint64 `json:"gas"`
+	BlockGasLimit hexutil.Uint64 `json:"blockGasLimit"`
+}
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"maps"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
 
 	rpc "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/x/vm/statedb"
@@ -110,3 +112,41 @@ func TestApply(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockOverridesApply(t *testing.T) {
+	coinbase := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	random := common.HexToHash("0x01")
+	number := hexutil.Big(*big.NewInt(100))
+	blockTime := hexutil.Uint64(1000)
+	gasLimit := hexutil.Uint64(30_000_000)
+	baseFee := hexutil.Big(*big.NewInt(7))
+
+	blockCtx := vm.BlockContext{BlockNumber: big.NewInt(1), Time: 1, GasLimit: 1, BaseFee: big.NewInt(1)}
+	overrides := &rpc.BlockOverrides{
+		Number:        &number,
+		Time:          &blockTime,
+		GasLimit:      &gasLimit,
+		FeeRecipient:  &coinbase,
+		PrevRandao:    &random,
+		BaseFeePerGas: &baseFee,
+	}
+	overrides.Apply(&blockCtx)
+
+	require.Equal(t, big.NewInt(100), blockCtx.BlockNumber)
+	require.Equal(t, uint64(1000), blockCtx.Time)
+	require.Equal(t, uint64(30_000_000), blockCtx.GasLimit)
+	require.Equal(t, coinbase, blockCtx.Coinbase)
+	require.Equal(t, &random, blockCtx.Random)
+	require.Equal(t, big.NewInt(7), blockCtx.BaseFee)
+
+	// the fields that are not overridden are kept
+	blockCtx = vm.BlockContext{BlockNumber: big.NewInt(1), Time: 1}
+	(&rpc.BlockOverrides{Time: &blockTime}).Apply(&blockCtx)
+	require.Equal(t, big.NewInt(1), blockCtx.BlockNumber)
+	require.Equal(t, uint64(1000), blockCtx.Time)
+
+	// nil overrides are a no-op
+	var nilOverrides *rpc.BlockOverrides
+	nilOverrides.Apply(&blockCtx)
+	require.Equal(t, uint64(1000), blockCtx.Time)
+}
@@ -537,3 +537,19 @@ func EffectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
 
 	return fee
 }
+
+// ConsensusReceipt returns the receipt of an eth tx with its consensus fields
+// set, i.e. the fields encoded in the receipts root of a block.
+func ConsensusReceipt(tx *ethtypes.Transaction, failed bool, cumulativeGasUsed uint64, logs []*ethtypes.Log) *ethtypes.Receipt {
+	status := ethtypes.ReceiptStatusSuccessful
+	if failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+	return &ethtypes.Receipt{
+		Type:              tx.Type(),
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Bloom:             ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
+		Logs:              logs,
+	}
+}
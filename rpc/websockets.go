@@ -3,6 +3,9 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"html"
@@ -11,13 +14,16 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -25,7 +31,9 @@ import (
 
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
 
@@ -34,8 +42,48 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// SyncingPollInterval is the interval of the sync progress checks of the syncing subscriptions
+	SyncingPollInterval = time.Second
+
+	// forwardedForHeader carries the address of the websocket client whose call is
+	// forwarded to the JSON-RPC server
+	forwardedForHeader = "X-Cosmos-Evm-Forwarded-For"
+	// forwardedSecretHeader carries the secret authenticating the forwarded calls
+	forwardedSecretHeader = "X-Cosmos-Evm-Forwarded-Secret"
 )
 
+// forwardSecret authenticates the calls forwarded by the websocket server to the
+// JSON-RPC server of the same process, so that the clients cannot spoof their
+// address.
+var forwardSecret = newForwardSecret()
+
+func newForwardSecret() string {
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(bz)
+}
+
+// WithForwardedRemoteAddr wraps the handler of the JSON-RPC server so that the
+// calls forwarded by the websocket server are attributed to the address of the
+// websocket client instead of the loopback address of the forwarding, e.g. by the
+// per-peer rate limits.
+func WithForwardedRemoteAddr(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr := r.Header.Get(forwardedForHeader)
+		secret := r.Header.Get(forwardedSecretHeader)
+		if addr != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(forwardSecret)) == 1 {
+			r.RemoteAddr = addr
+		}
+		r.Header.Del(forwardedForHeader)
+		r.Header.Del(forwardedSecretHeader)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -194,8 +242,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:        new(sync.Mutex),
+		conn:       conn,
+		remoteAddr: r.RemoteAddr,
 	}
 
 	s.readLoop(ws)
@@ -217,6 +266,8 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// remoteAddr is the address of the client, forwarded with its calls
+	remoteAddr string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -388,6 +439,8 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(forwardedForHeader, wsConn.remoteAddr)
+	req.Header.Set(forwardedSecretHeader, forwardSecret)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -443,7 +496,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -626,7 +682,69 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
+	crit, err := parsePendingTxsCriteria(extra)
+	if err != nil {
+		api.logger.Debug("invalid pending transactions criteria", "type", fmt.Sprintf("%T", extra))
+		return nil, err
+	}
+
+	if !crit.FullTx && len(crit.From) == 0 && len(crit.To) == 0 {
+		return api.subscribePendingTxHashes(wsConn, subID)
+	}
+
+	txStream := api.events.PendingFullTxStream()
+	if txStream == nil {
+		return nil, errors.New("full transactions and address filters require the EVM mempool")
+	}
+
+	var chainConfig *params.ChainConfig
+	if crit.FullTx {
+		chainConfig = evmtypes.GetEthChainConfig()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go txStream.Subscribe(ctx, func(txs []*ethtypes.Transaction, _ int) error {
+		for _, tx := range txs {
+			if !crit.matches(tx) {
+				continue
+			}
+
+			var result interface{} = tx.Hash()
+			if crit.FullTx {
+				result = rpctypes.NewRPCPendingTransaction(tx, nil, chainConfig)
+			}
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				api.logger.Debug("error writing pending transaction, will drop peer", "error", err.Error())
+
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close()
+					}
+				}, api.logger, "closing websocket peer sub")
+				return err
+			}
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+func (api *pubSubAPI) subscribePendingTxHashes(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	//nolint: errcheck
 	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
@@ -659,8 +777,145 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// pendingTxsCriteria are the options of the newPendingTransactions
+// subscription. The transactions are notified if their sender is one of From
+// and their recipient one of To, an empty list matches any address.
+type pendingTxsCriteria struct {
+	FullTx bool
+	From   []common.Address
+	To     []common.Address
+}
+
+// parsePendingTxsCriteria parses the extra parameter of the
+// newPendingTransactions subscription, either the geth fullTx boolean or an
+// object with the fullTx, from and to fields.
+func parsePendingTxsCriteria(extra interface{}) (pendingTxsCriteria, error) {
+	var (
+		crit pendingTxsCriteria
+		err  error
+	)
+
+	switch params := extra.(type) {
+	case nil:
+	case bool:
+		crit.FullTx = params
+	case map[string]interface{}:
+		if params["fullTx"] != nil {
+			fullTx, ok := params["fullTx"].(bool)
+			if !ok {
+				return crit, errors.New("invalid fullTx; must be a boolean")
+			}
+			crit.FullTx = fullTx
+		}
+		if crit.From, err = parseAddresses(params["from"]); err != nil {
+			return crit, err
+		}
+		if crit.To, err = parseAddresses(params["to"]); err != nil {
+			return crit, err
+		}
+	default:
+		return crit, errors.New("invalid criteria")
+	}
+	return crit, nil
+}
+
+// parseAddresses parses an address or an array of addresses.
+func parseAddresses(value interface{}) ([]common.Address, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(value) {
+			return nil, errors.Errorf("invalid address %s", value)
+		}
+		return []common.Address{common.HexToAddress(value)}, nil
+	case []interface{}:
+		addresses := make([]common.Address, 0, len(value))
+		for _, item := range value {
+			address, ok := item.(string)
+			if !ok || !common.IsHexAddress(address) {
+				return nil, errors.New("invalid address")
+			}
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("invalid addresses; must be address or array of addresses")
+	}
+}
+
+// matches returns true if the sender and the recipient of the transaction
+// match the criteria. Contract creations have no recipient and never match a
+// recipient filter.
+func (crit pendingTxsCriteria) matches(tx *ethtypes.Transaction) bool {
+	if len(crit.From) > 0 {
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil || !slices.Contains(crit.From, from) {
+			return false
+		}
+	}
+	if len(crit.To) > 0 {
+		if tx.To() == nil || !slices.Contains(crit.To, *tx.To()) {
+			return false
+		}
+	}
+	return true
+}
+
+// syncingResult is the notification of the syncing subscription when the
+// node starts catching up, the end of the sync is notified with false.
+type syncingResult struct {
+	Syncing bool                   `json:"syncing"`
+	Status  map[string]interface{} `json:"status"`
+}
+
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(SyncingPollInterval)
+		defer ticker.Stop()
+
+		var syncing bool
+		for {
+			progress, err := rpctypes.SyncProgress(ctx, api.clientCtx.Client)
+			if err != nil {
+				api.logger.Debug("failed to get sync progress", "error", err.Error())
+			} else if (progress != nil) != syncing {
+				syncing = progress != nil
+
+				var result interface{} = false
+				if syncing {
+					result = &syncingResult{Syncing: true, Status: progress}
+				}
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -1,15 +1,26 @@
 package rpc
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/server/config"
 
@@ -62,6 +73,44 @@ func TestWebsocketPayloadLimit(t *testing.T) {
 	require.Error(t, readErr, "expected connection to close on oversized message")
 }
 
+func TestForwardedRemoteAddr(t *testing.T) {
+	remoteAddrs := make(chan string, 1)
+	rpcSrv := httptest.NewServer(WithForwardedRemoteAddr(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remoteAddrs <- r.RemoteAddr
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})))
+	defer rpcSrv.Close()
+
+	srv := newTestWebsocketServer()
+	srv.rpcAddr = strings.TrimPrefix(rpcSrv.URL, "http://")
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the calls forwarded by the websocket server are attributed to the client
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "eth_chainId", "params": []interface{}{}}))
+	var res map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, "0x1", res["result"])
+	require.Equal(t, conn.LocalAddr().String(), <-remoteAddrs)
+
+	// the forwarded address is ignored without the secret of the websocket server
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, rpcSrv.URL, strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Header.Set(forwardedForHeader, "203.0.113.1:1234")
+	req.Header.Set(forwardedSecretHeader, "invalid")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NotEqual(t, "203.0.113.1:1234", <-remoteAddrs)
+}
+
 func TestCheckOrigin(t *testing.T) {
 	logger := log.NewNopLogger()
 	tests := []struct {
@@ -214,3 +263,171 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+// syncingClient reports the node as catching up until its first blocks are
+// synced.
+type syncingClient struct {
+	client.CometRPC
+	calls int
+}
+
+func (c *syncingClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.calls++
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		LatestBlockHeight: int64(c.calls),
+		CatchingUp:        c.calls < 2,
+	}}, nil
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	srv := newTestWebsocketServer()
+	srv.api.clientCtx = client.Context{}.WithClient(&syncingClient{})
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_subscribe",
+		"params":  []interface{}{"syncing"},
+	}))
+	var subRes SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&subRes))
+	require.NotNil(t, subRes.Result)
+
+	// the start of the sync is notified with the progress, the end with false
+	var notification struct {
+		Params struct {
+			Result json.RawMessage `json:"result"`
+		} `json:"params"`
+	}
+	require.NoError(t, conn.ReadJSON(&notification))
+	require.JSONEq(t, `{"syncing":true,"status":{"startingBlock":"0x0","currentBlock":"0x1","highestBlock":"0x1"}}`, string(notification.Params.Result))
+
+	require.NoError(t, conn.ReadJSON(&notification))
+	require.JSONEq(t, `false`, string(notification.Params.Result))
+}
+
+// txPoolFeed feeds the pending transactions of the tests.
+type txPoolFeed struct {
+	feed event.Feed
+}
+
+func (f *txPoolFeed) SubscribeTransactions(ch chan<- core.NewTxsEvent, _ bool) event.Subscription {
+	return f.feed.Subscribe(ch)
+}
+
+func TestParsePendingTxsCriteria(t *testing.T) {
+	addr1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	addr2 := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	testCases := []struct {
+		name    string
+		extra   interface{}
+		expCrit pendingTxsCriteria
+		expErr  bool
+	}{
+		{"no params", nil, pendingTxsCriteria{}, false},
+		{"geth full tx", true, pendingTxsCriteria{FullTx: true}, false},
+		{
+			"addresses",
+			map[string]interface{}{"fullTx": true, "from": addr1.Hex(), "to": []interface{}{addr1.Hex(), addr2.Hex()}},
+			pendingTxsCriteria{FullTx: true, From: []common.Address{addr1}, To: []common.Address{addr1, addr2}},
+			false,
+		},
+		{"invalid full tx", map[string]interface{}{"fullTx": "yes"}, pendingTxsCriteria{}, true},
+		{"invalid address", map[string]interface{}{"from": "0x01"}, pendingTxsCriteria{}, true},
+		{"invalid addresses", map[string]interface{}{"to": []interface{}{1}}, pendingTxsCriteria{}, true},
+		{"invalid params", "full", pendingTxsCriteria{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crit, err := parsePendingTxsCriteria(tc.extra)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCrit, crit)
+		})
+	}
+}
+
+func TestSubscribePendingTransactionsFilter(t *testing.T) {
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key1.PublicKey)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	signer := ethtypes.LatestSignerForChainID(common.Big1)
+	newTx := func(key *ecdsa.PrivateKey, to *common.Address) *ethtypes.Transaction {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{ChainID: common.Big1, To: to, Gas: 21000})
+		require.NoError(t, err)
+		return tx
+	}
+
+	feed := &txPoolFeed{}
+	events := stream.NewRPCStreams(nil, log.NewNopLogger(), nil)
+	events.ListenTxPool(feed)
+	defer events.Close()
+
+	srv := newTestWebsocketServer()
+	srv.api.events = events
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_subscribe",
+		"params":  []interface{}{"newPendingTransactions", map[string]interface{}{"from": from.Hex(), "to": to.Hex()}},
+	}))
+	var subRes SubscriptionResponseJSON
+	require.NoError(t, conn.ReadJSON(&subRes))
+	require.NotNil(t, subRes.Result)
+
+	// only the transaction from the sender to the recipient is notified, the
+	// transactions are fed until the subscription reads the stream
+	match := newTx(key1, &to)
+	txs := []*ethtypes.Transaction{newTx(key2, &to), newTx(key1, nil), match}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				feed.feed.Send(core.NewTxsEvent{Txs: txs})
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var notification struct {
+		Params struct {
+			Result common.Hash `json:"result"`
+		} `json:"params"`
+	}
+	require.NoError(t, conn.ReadJSON(&notification))
+	require.Equal(t, match.Hash(), notification.Params.Result)
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -67,6 +68,15 @@ const (
 	// DefaultEVMMinTip is the default minimum priority fee for the mempool
 	DefaultEVMMinTip = 0
 
+	// SelectionPolicyTip selects the mempool transactions by effective tip
+	SelectionPolicyTip = "tip"
+
+	// SelectionPolicyLanes selects the mempool transactions within the gas quotas of the EVM and Cosmos lanes
+	SelectionPolicyLanes = "lanes"
+
+	// DefaultSelectionPolicy is the default block building policy of the mempool
+	DefaultSelectionPolicy = SelectionPolicyTip
+
 	// DefaultGethMetricsAddress is the default port for the geth metrics server.
 	DefaultGethMetricsAddress = "127.0.0.1:8100"
 
@@ -88,6 +98,9 @@ const (
 	// DefaultBlockRangeCap is the default cap of block range allowed for 'eth_getLogs' query
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultTraceFilterCap is the default cap of block range traced by a 'trace_filter' query
+	DefaultTraceFilterCap int32 = 100
+
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
@@ -122,6 +135,33 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultEnableCosmosTxCancel toggles whether the Cosmos transactions can be cancelled in the `miner` namespace
+	DefaultEnableCosmosTxCancel = false
+
+	// IndexerBackendKV is the custom indexer backend storing the txs in a KV db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendSQLite is the custom indexer backend storing the txs in a SQLite db
+	IndexerBackendSQLite = "sqlite"
+
+	// IndexerBackendPostgres is the custom indexer backend storing the txs in a PostgreSQL db
+	IndexerBackendPostgres = "postgres"
+
+	// DefaultIndexerBackend is the default backend of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultTraceCacheSize is the default size in bytes of the block traces kept
+	// in memory, the trace cache is disabled by default
+	DefaultTraceCacheSize = 0
+
+	// DefaultStateMirrorInterval is the default number of blocks between the
+	// state roots published by the state mirror
+	DefaultStateMirrorInterval = 1
+
+	// DefaultStateMirrorKeepRoots is the default number of latest state roots
+	// kept by the state mirror
+	DefaultStateMirrorKeepRoots = 1000
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -151,6 +191,9 @@ type EVMConfig struct {
 	MinTip uint64 `mapstructure:"min-tip"`
 	// GethMetricsAddress is the address the geth metrics server will bind to. Default 127.0.0.1:8100
 	GethMetricsAddress string `mapstructure:"geth-metrics-address"`
+	// EnableTransferLogs emits ERC-20 Transfer logs for the movements of the native token pairs
+	// made outside of the EVM
+	EnableTransferLogs bool `mapstructure:"enable-transfer-logs"`
 	// Mempool defines the EVM mempool configuration
 	Mempool MempoolConfig `mapstructure:"mempool"`
 }
@@ -171,6 +214,42 @@ type MempoolConfig struct {
 	GlobalQueue uint64 `mapstructure:"global-queue"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// Journal is the file of the transactions journal surviving node restarts, relative
+	// to the data directory of the node. The journal is disabled if empty.
+	Journal string `mapstructure:"journal"`
+	// Rejournal is the time interval to regenerate the transactions journal
+	Rejournal time.Duration `mapstructure:"rejournal"`
+	// Locals are the addresses whose transactions are journaled, all addresses if empty
+	Locals []string `mapstructure:"locals"`
+	// MaxNonceGap is the maximum gap between the nonce of a transaction and its sender's
+	// pending nonce, unlimited if zero
+	MaxNonceGap uint64 `mapstructure:"max-nonce-gap"`
+	// RequireQueuedFunds defines if the balance of a sender must cover the cost of its
+	// queued transactions too, not only of its pending ones
+	RequireQueuedFunds bool `mapstructure:"require-queued-funds"`
+	// GapEviction defines if the queued transactions farthest from their sender's pending
+	// nonce are evicted first when the queue is full, instead of those of the least recently
+	// active senders
+	GapEviction bool `mapstructure:"gap-eviction"`
+	// QueuedRateLimit is the maximum number of queued transactions each peer can submit over
+	// JSON-RPC per minute, unlimited if zero
+	QueuedRateLimit uint64 `mapstructure:"queued-rate-limit"`
+	// SelectionPolicy defines how the EVM and Cosmos transactions are interleaved in the
+	// proposed blocks, either by effective tip (tip) or within per lane gas quotas (lanes)
+	SelectionPolicy string `mapstructure:"selection-policy"`
+	// EVMLaneWeight is the weight of the EVM lane in the block gas, used by the lanes policy
+	EVMLaneWeight uint64 `mapstructure:"evm-lane-weight"`
+	// CosmosLaneWeight is the weight of the Cosmos lane in the block gas, used by the lanes policy
+	CosmosLaneWeight uint64 `mapstructure:"cosmos-lane-weight"`
+	// ReservedBlockSpace is the percentage of the block gas reserved to the Cosmos transactions
+	// of the reserved message types. The reservation is disabled if zero.
+	ReservedBlockSpace uint64 `mapstructure:"reserved-block-space"`
+	// ReservedMsgTypes are the type URLs of the messages of the transactions using the reserved
+	// block space, all the messages of a transaction must be of these types
+	ReservedMsgTypes []string `mapstructure:"reserved-msg-types"`
+	// CosmosPriceBump is the minimum priority bump percentage for a Cosmos transaction to replace
+	// the one of the same signer and sequence
+	CosmosPriceBump uint64 `mapstructure:"cosmos-price-bump"`
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -183,6 +262,21 @@ func DefaultMempoolConfig() MempoolConfig {
 		AccountQueue: 64,            // 64 non-executable transaction slots per account
 		GlobalQueue:  1024,          // 1024 global non-executable slots
 		Lifetime:     3 * time.Hour, // 3 hour lifetime for queued transactions
+		Journal:      "",            // the journal is disabled by default
+		Rejournal:    time.Hour,     // regenerate the journal every hour
+		Locals:       []string{},
+
+		SelectionPolicy:    DefaultSelectionPolicy,
+		EVMLaneWeight:      1,
+		CosmosLaneWeight:   1,
+		ReservedBlockSpace: 0,
+		ReservedMsgTypes: []string{
+			"/ibc.core.client.v1.MsgUpdateClient",
+			"/ibc.core.channel.v1.MsgRecvPacket",
+			"/ibc.core.channel.v1.MsgAcknowledgement",
+			"/ibc.core.channel.v1.MsgTimeout",
+		},
+		CosmosPriceBump: 10, // 10% priority bump to replace a Cosmos transaction
 	}
 }
 
@@ -209,6 +303,32 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	if c.Journal != "" && c.Rejournal < time.Second {
+		return fmt.Errorf("rejournal must be at least 1 second, got %s", c.Rejournal)
+	}
+	for _, local := range c.Locals {
+		if !common.IsHexAddress(local) {
+			return fmt.Errorf("invalid local address %s", local)
+		}
+	}
+	switch c.SelectionPolicy {
+	case "", SelectionPolicyTip:
+	case SelectionPolicyLanes:
+		if c.EVMLaneWeight+c.CosmosLaneWeight == 0 {
+			return errors.New("at least one of the lane weights must be positive")
+		}
+	default:
+		return fmt.Errorf("invalid selection policy %s, must be one of %s, %s", c.SelectionPolicy, SelectionPolicyTip, SelectionPolicyLanes)
+	}
+	if c.ReservedBlockSpace > 100 {
+		return fmt.Errorf("reserved block space must be at most 100 percent, got %d", c.ReservedBlockSpace)
+	}
+	if c.ReservedBlockSpace > 0 && len(c.ReservedMsgTypes) == 0 {
+		return errors.New("reserved msg types cannot be empty if the reserved block space is enabled")
+	}
+	if c.CosmosPriceBump < 1 {
+		return fmt.Errorf("cosmos price bump must be at least 1, got %d", c.CosmosPriceBump)
+	}
 	return nil
 }
 
@@ -238,6 +358,8 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// TraceFilterCap defines the max number of blocks traced by a `trace_filter` query.
+	TraceFilterCap int32 `mapstructure:"trace-filter-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -254,12 +376,37 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableAddressIndex defines if the custom indexer indexes the txs by address.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// EnableLogIndex defines if the kv backend of the custom indexer indexes the log addresses and topics.
+	EnableLogIndex bool `mapstructure:"enable-log-index"`
+	// IndexerBackend defines the storage backend of the custom indexer (kv|sqlite|postgres).
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerDSN defines the data source name of the sql backends of the custom indexer.
+	IndexerDSN string `mapstructure:"indexer-dsn"`
+	// TraceCacheSize defines the size in bytes of the block traces kept in memory and in the
+	// persistent store, 0 disables the trace cache.
+	TraceCacheSize uint64 `mapstructure:"trace-cache-size"`
+	// TraceCachePrewarm defines if a transaction trace traces and caches its whole block.
+	TraceCachePrewarm bool `mapstructure:"trace-cache-prewarm"`
+	// TraceCachePersist defines if the block traces are also stored in a db next to the indexer db.
+	TraceCachePersist bool `mapstructure:"trace-cache-persist"`
+	// EnableStateMirror defines if the node maintains a Merkle-Patricia mirror of the EVM state
+	// to serve Ethereum-format proofs from eth_getProof.
+	EnableStateMirror bool `mapstructure:"enable-state-mirror"`
+	// StateMirrorInterval defines the number of blocks between the state roots published by the state mirror.
+	StateMirrorInterval uint64 `mapstructure:"state-mirror-interval"`
+	// StateMirrorKeepRoots defines the number of latest state roots kept by the state mirror, all of them if 0.
+	StateMirrorKeepRoots uint64 `mapstructure:"state-mirror-keep-roots"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableCosmosTxCancel enables the cancellation of the Cosmos transactions of any signer in the `miner`
+	// namespace. SHOULD only be used on nodes whose `miner` namespace is only reachable by their operator
+	EnableCosmosTxCancel bool `mapstructure:"enable-cosmos-tx-cancel"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -307,7 +454,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -329,6 +476,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FilterCap:            DefaultFilterCap,
 		FeeHistoryCap:        DefaultFeeHistoryCap,
 		BlockRangeCap:        DefaultBlockRangeCap,
+		TraceFilterCap:       DefaultTraceFilterCap,
 		LogsCap:              DefaultLogsCap,
 		HTTPTimeout:          DefaultHTTPTimeout,
 		HTTPIdleTimeout:      DefaultHTTPIdleTimeout,
@@ -337,9 +485,19 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		EnableAddressIndex:   false,
+		EnableLogIndex:       true,
+		IndexerBackend:       DefaultIndexerBackend,
+		TraceCacheSize:       DefaultTraceCacheSize,
+		TraceCachePrewarm:    false,
+		TraceCachePersist:    false,
+		EnableStateMirror:    false,
+		StateMirrorInterval:  DefaultStateMirrorInterval,
+		StateMirrorKeepRoots: DefaultStateMirrorKeepRoots,
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		EnableCosmosTxCancel: DefaultEnableCosmosTxCancel,
 	}
 }
 
@@ -373,6 +531,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.TraceFilterCap < 0 {
+		return errors.New("JSON-RPC trace filter block range cap cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -389,6 +551,24 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if (c.TraceCachePrewarm || c.TraceCachePersist) && c.TraceCacheSize == 0 {
+		return errors.New("JSON-RPC trace cache prewarm and persist require a non-zero trace cache size")
+	}
+
+	if c.EnableStateMirror && c.StateMirrorInterval == 0 {
+		return errors.New("JSON-RPC state mirror interval cannot be 0")
+	}
+
+	switch c.IndexerBackend {
+	case IndexerBackendKV, IndexerBackendSQLite:
+	case IndexerBackendPostgres:
+		if c.IndexerDSN == "" {
+			return errors.New("JSON-RPC indexer dsn is required by the postgres indexer backend")
+		}
+	default:
+		return fmt.Errorf("unknown JSON-RPC indexer backend, expect: kv|sqlite|postgres, got: %s", c.IndexerBackend)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
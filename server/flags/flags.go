@@ -49,14 +49,26 @@ const (
 	JSONRPCFilterCap            = "json-rpc.filter-cap"
 	JSONRPCLogsCap              = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
+	JSONRPCTraceFilterCap       = "json-rpc.trace-filter-cap"
 	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCEnableAddressIndex   = "json-rpc.enable-address-index"
+	JSONRPCEnableLogIndex       = "json-rpc.enable-log-index"
+	JSONRPCIndexerBackend       = "json-rpc.indexer-backend"
+	JSONRPCIndexerDSN           = "json-rpc.indexer-dsn"
+	JSONRPCTraceCacheSize       = "json-rpc.trace-cache-size"
+	JSONRPCTraceCachePrewarm    = "json-rpc.trace-cache-prewarm"
+	JSONRPCTraceCachePersist    = "json-rpc.trace-cache-persist"
+	JSONRPCEnableStateMirror    = "json-rpc.enable-state-mirror"
+	JSONRPCStateMirrorInterval  = "json-rpc.state-mirror-interval"
+	JSONRPCStateMirrorKeepRoots = "json-rpc.state-mirror-keep-roots"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnableCosmosTxCancel = "json-rpc.enable-cosmos-tx-cancel"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -71,6 +83,7 @@ const (
 	EVMChainID                 = "evm.evm-chain-id"
 	EVMMinTip                  = "evm.min-tip"
 	EvmGethMetricsAddress      = "evm.geth-metrics-address"
+	EVMEnableTransferLogs      = "evm.enable-transfer-logs"
 
 	EVMMempoolPriceLimit   = "evm.mempool.price-limit"
 	EVMMempoolPriceBump    = "evm.mempool.price-bump"
@@ -79,6 +92,22 @@ const (
 	EVMMempoolAccountQueue = "evm.mempool.account-queue"
 	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
 	EVMMempoolLifetime     = "evm.mempool.lifetime"
+	EVMMempoolJournal      = "evm.mempool.journal"
+	EVMMempoolRejournal    = "evm.mempool.rejournal"
+	EVMMempoolLocals       = "evm.mempool.locals"
+
+	EVMMempoolMaxNonceGap        = "evm.mempool.max-nonce-gap"
+	EVMMempoolRequireQueuedFunds = "evm.mempool.require-queued-funds"
+	EVMMempoolGapEviction        = "evm.mempool.gap-eviction"
+	EVMMempoolQueuedRateLimit    = "evm.mempool.queued-rate-limit"
+
+	EVMMempoolSelectionPolicy    = "evm.mempool.selection-policy"
+	EVMMempoolEVMLaneWeight      = "evm.mempool.evm-lane-weight"
+	EVMMempoolCosmosLaneWeight   = "evm.mempool.cosmos-lane-weight"
+	EVMMempoolReservedBlockSpace = "evm.mempool.reserved-block-space"
+	EVMMempoolReservedMsgTypes   = "evm.mempool.reserved-msg-types"
+
+	EVMMempoolCosmosPriceBump = "evm.mempool.cosmos-price-bump"
 )
 
 // TLS flags
@@ -9,7 +9,7 @@ import (
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
 
-	"github.com/cosmos/evm/indexer"
+	srvflags "github.com/cosmos/evm/server/flags"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -18,11 +18,16 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|reindex]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- reindex: index again the blocks already indexed, from the latest indexed block to the first one, to backfill the log index and the address indexes.
+
+		The address indexes are populated when enabled with json-rpc.enable-address-index, reindex always enables them.
+		The log index only covers a contiguous block range, indexing a block out of it restarts the range from that block.
+		The indexer backend is selected with json-rpc.indexer-backend, the sql backends always index the addresses and logs.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -36,19 +41,28 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "reindex" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|reindex, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
-			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			addressIndex := direction == "reindex" || serverCtx.Viper.GetBool(srvflags.JSONRPCEnableAddressIndex)
+			// the log index is enabled by default
+			logIndex := !serverCtx.Viper.IsSet(srvflags.JSONRPCEnableLogIndex) || serverCtx.Viper.GetBool(srvflags.JSONRPCEnableLogIndex)
+			idxer, err := OpenEVMTxIndexer(
+				serverCtx,
+				clientCtx,
+				serverCtx.Viper.GetString(srvflags.JSONRPCIndexerBackend),
+				serverCtx.Viper.GetString(srvflags.JSONRPCIndexerDSN),
+				addressIndex,
+				logIndex,
+				logger.With("module", "evmindex"),
+			)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				logger.Error("failed to open evm indexer", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -110,6 +124,21 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "reindex":
+				// indexing a block again is idempotent, the tx results are unchanged
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				for i := latest; i >= first && i > 0; i-- {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
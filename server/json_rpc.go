@@ -15,11 +15,16 @@ import (
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	dbm "github.com/cosmos/cosmos-db"
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/statemirror"
+
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -31,6 +36,12 @@ type AppWithPendingTxStream interface {
 	RegisterPendingTxListener(listener func(common.Hash))
 }
 
+// AppWithStateMirror defines an application maintaining a Merkle-Patricia
+// mirror of the EVM state, to serve Ethereum-format proofs.
+type AppWithStateMirror interface {
+	GetStateMirror() *statemirror.StateMirror
+}
+
 // StartJSONRPC starts the JSON-RPC server
 func StartJSONRPC(
 	ctx context.Context,
@@ -41,6 +52,7 @@ func StartJSONRPC(
 	indexer types.EVMTxIndexer,
 	app AppWithPendingTxStream,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	stateMirror *statemirror.StateMirror,
 ) (*http.Server, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 
@@ -51,6 +63,9 @@ func StartJSONRPC(
 
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	if mempool != nil {
+		stream.ListenTxPool(mempool.GetTxPool())
+	}
 
 	// Set Geth's global logger to use this handler
 	handler := &CustomSlogHandler{logger: logger}
@@ -62,7 +77,12 @@ func StartJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool)
+	traceCache, err := OpenTraceCache(srvCtx, config.JSONRPC, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool, traceCache, stateMirror)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -71,6 +91,7 @@ func StartJSONRPC(
 				"namespace", api.Namespace,
 				"service", api.Service,
 			)
+			closeTraceCache(traceCache, logger)
 			return nil, err
 		}
 	}
@@ -85,7 +106,7 @@ func StartJSONRPC(
 
 	httpSrv := &http.Server{
 		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Handler:           handlerWithCors.Handler(rpc.WithForwardedRemoteAddr(r)),
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -95,10 +116,13 @@ func StartJSONRPC(
 
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
+		closeTraceCache(traceCache, logger)
 		return nil, err
 	}
 
 	g.Go(func() error {
+		defer closeTraceCache(traceCache, logger)
+
 		srvCtx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
 		errCh := make(chan error)
 		go func() {
@@ -135,3 +159,35 @@ func StartJSONRPC(
 	wsSrv.Start()
 	return httpSrv, nil
 }
+
+// OpenTraceCache opens the cache of the block traces shared by the tracing
+// endpoints. It returns nil when the trace cache is disabled.
+func OpenTraceCache(srvCtx *server.Context, config serverconfig.JSONRPCConfig, logger log.Logger) (*backend.TraceCache, error) {
+	if config.TraceCacheSize == 0 {
+		return nil, nil
+	}
+
+	var db dbm.DB
+	if config.TraceCachePersist {
+		var err error
+		db, err = OpenTraceCacheDB(srvCtx.Config.RootDir, server.GetAppDBBackend(srvCtx.Viper))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	traceCache, err := backend.NewTraceCache(config.TraceCacheSize, db, logger.With("module", "trace-cache"))
+	if err != nil && db != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			logger.Error("failed to close the trace cache db", "error", closeErr.Error())
+		}
+	}
+	return traceCache, err
+}
+
+// closeTraceCache closes the trace cache, logging the failures.
+func closeTraceCache(traceCache *backend.TraceCache, logger log.Logger) {
+	if err := traceCache.Close(); err != nil {
+		logger.Error("failed to close the trace cache", "error", err.Error())
+	}
+}
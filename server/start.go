@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
@@ -15,6 +17,10 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	// register the drivers of the sql indexer backends
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -34,6 +40,7 @@ import (
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/statemirror"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -212,10 +219,22 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceFilterCap, cosmosevmserverconfig.DefaultTraceFilterCap, "Sets the max number of blocks traced by a `trace_filter` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndex, false, "Enable the indexes of the txs by address in the custom tx indexer")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndex, true, "Enable the index of the log addresses and topics in the kv backend of the custom tx indexer")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the storage backend of the custom tx indexer (kv|sqlite|postgres)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerDSN, "", "Sets the data source name of the sql backends of the custom tx indexer")
+	cmd.Flags().Uint64(srvflags.JSONRPCTraceCacheSize, cosmosevmserverconfig.DefaultTraceCacheSize, "Sets the size in bytes of the block traces kept in memory and in the persistent store (0=disabled)")
+	cmd.Flags().Bool(srvflags.JSONRPCTraceCachePrewarm, false, "Trace and cache the whole block of the transactions traced with debug_traceTransaction")
+	cmd.Flags().Bool(srvflags.JSONRPCTraceCachePersist, false, "Store the block traces in a db next to the custom tx indexer db")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableStateMirror, false, "Maintain a Merkle-Patricia mirror of the EVM state to serve Ethereum-format proofs from eth_getProof")
+	cmd.Flags().Uint64(srvflags.JSONRPCStateMirrorInterval, cosmosevmserverconfig.DefaultStateMirrorInterval, "Sets the number of blocks between the state roots published by the state mirror")
+	cmd.Flags().Uint64(srvflags.JSONRPCStateMirrorKeepRoots, cosmosevmserverconfig.DefaultStateMirrorKeepRoots, "Sets the number of latest state roots kept by the state mirror, all of them if 0")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableCosmosTxCancel, cosmosevmserverconfig.DefaultEnableCosmosTxCancel, "Enables the cancellation of the Cosmos transactions of any signer in the miner namespace, only for nodes whose miner namespace is reachable by their operator only") //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -223,6 +242,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 	cmd.Flags().Uint64(srvflags.EVMMinTip, cosmosevmserverconfig.DefaultEVMMinTip, "the minimum priority fee for the mempool")
 	cmd.Flags().String(srvflags.EvmGethMetricsAddress, cosmosevmserverconfig.DefaultGethMetricsAddress, "the address to bind the geth metrics server to")
+	cmd.Flags().Bool(srvflags.EVMEnableTransferLogs, false, "Emit ERC-20 Transfer logs for the movements of the native token pairs made outside of the EVM")
 
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceLimit, cosmosevmserverconfig.DefaultMempoolConfig().PriceLimit, "the minimum gas price to enforce for acceptance into the pool (in wei)")
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultMempoolConfig().PriceBump, "the minimum price bump percentage to replace an already existing transaction (nonce)")
@@ -231,6 +251,19 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolConfig().Journal, "the file of the transactions journal relative to the data directory, disabled if empty")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolConfig().Rejournal, "the time interval to regenerate the transactions journal")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolLocals, cosmosevmserverconfig.DefaultMempoolConfig().Locals, "the addresses whose transactions are journaled, all addresses if empty")
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxNonceGap, cosmosevmserverconfig.DefaultMempoolConfig().MaxNonceGap, "the maximum gap between the nonce of a transaction and its sender's pending nonce, unlimited if zero")
+	cmd.Flags().Bool(srvflags.EVMMempoolRequireQueuedFunds, cosmosevmserverconfig.DefaultMempoolConfig().RequireQueuedFunds, "require the balance of a sender to cover the cost of its queued transactions too")
+	cmd.Flags().Bool(srvflags.EVMMempoolGapEviction, cosmosevmserverconfig.DefaultMempoolConfig().GapEviction, "evict the queued transactions farthest from their sender's pending nonce first")
+	cmd.Flags().Uint64(srvflags.EVMMempoolQueuedRateLimit, cosmosevmserverconfig.DefaultMempoolConfig().QueuedRateLimit, "the maximum number of queued transactions each peer can submit over JSON-RPC per minute, unlimited if zero")
+	cmd.Flags().String(srvflags.EVMMempoolSelectionPolicy, cosmosevmserverconfig.DefaultMempoolConfig().SelectionPolicy, "the policy interleaving the EVM and Cosmos transactions in the proposed blocks (tip|lanes)")
+	cmd.Flags().Uint64(srvflags.EVMMempoolEVMLaneWeight, cosmosevmserverconfig.DefaultMempoolConfig().EVMLaneWeight, "the weight of the EVM lane in the block gas, used by the lanes policy")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosLaneWeight, cosmosevmserverconfig.DefaultMempoolConfig().CosmosLaneWeight, "the weight of the Cosmos lane in the block gas, used by the lanes policy")
+	cmd.Flags().Uint64(srvflags.EVMMempoolReservedBlockSpace, cosmosevmserverconfig.DefaultMempoolConfig().ReservedBlockSpace, "the percentage of the block gas reserved to the Cosmos transactions of the reserved message types, disabled if zero")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolReservedMsgTypes, cosmosevmserverconfig.DefaultMempoolConfig().ReservedMsgTypes, "the message type URLs of the transactions using the reserved block space")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosPriceBump, cosmosevmserverconfig.DefaultMempoolConfig().CosmosPriceBump, "the minimum priority bump percentage to replace a Cosmos transaction (signer and sequence)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -478,14 +511,16 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = OpenEVMTxIndexer(svrCtx, clientCtx, config.JSONRPC.IndexerBackend, config.JSONRPC.IndexerDSN, config.JSONRPC.EnableAddressIndex, config.JSONRPC.EnableLogIndex, idxLogger)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			logger.Error("failed to open evm indexer", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvBackend := config.JSONRPC.IndexerBackend == "" || config.JSONRPC.IndexerBackend == cosmosevmserverconfig.IndexerBackendKV
+		if kvBackend && !config.JSONRPC.EnableAddressIndex && slices.Contains(config.JSONRPC.API, "ots") {
+			logger.Warn("the ots namespace is enabled without the address index, its address lookups will fail; enable json-rpc.enable-address-index")
+		}
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -539,7 +574,11 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		if !ok {
 			return fmt.Errorf("json-rpc server requires AppWithPendingTxStream")
 		}
-		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool))
+		var stateMirror *statemirror.StateMirror
+		if mirrorApp, ok := app.(AppWithStateMirror); ok {
+			stateMirror = mirrorApp.GetStateMirror()
+		}
+		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool), stateMirror)
 		if err != nil {
 			return err
 		}
@@ -564,6 +603,67 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenTraceCacheDB opens the db of the persistent trace cache, next to the custom eth indexer db
+func OpenTraceCacheDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmtracecache", backendType, dataDir)
+}
+
+// OpenEVMTxIndexer opens the custom eth indexer of the backend, the address
+// and log indexes are only optional for the kv backend.
+func OpenEVMTxIndexer(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	backend, dsn string,
+	addressIndex, logIndex bool,
+	logger log.Logger,
+) (servertypes.EVMTxIndexer, error) {
+	home := svrCtx.Config.RootDir
+	switch backend {
+	case "", cosmosevmserverconfig.IndexerBackendKV:
+		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		if err != nil {
+			return nil, err
+		}
+		var idxOpts []indexer.KVIndexerOption
+		if addressIndex {
+			idxOpts = append(idxOpts, indexer.WithAddressIndex())
+		}
+		if !logIndex {
+			idxOpts = append(idxOpts, indexer.WithoutLogIndex())
+		}
+		return indexer.NewKVIndexer(idxDB, logger, clientCtx, idxOpts...), nil
+	case cosmosevmserverconfig.IndexerBackendSQLite, cosmosevmserverconfig.IndexerBackendPostgres:
+		db, err := OpenSQLIndexerDB(home, backend, dsn)
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewSQLIndexer(db, backend, logger, clientCtx)
+	default:
+		return nil, fmt.Errorf("unknown indexer backend, expect: kv|sqlite|postgres, got: %s", backend)
+	}
+}
+
+// OpenSQLIndexerDB opens the sql db of the custom eth indexer, the sqlite db
+// is stored in the data directory if the dsn is empty.
+func OpenSQLIndexerDB(rootDir, driver, dsn string) (*sql.DB, error) {
+	if driver == indexer.SQLDriverSQLite && dsn == "" {
+		dsn = filepath.Join(rootDir, "data", "evmindexer.sqlite")
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == indexer.SQLDriverSQLite {
+		// sqlite doesn't support concurrent writers
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to connect to the %s indexer db", driver)
+	}
+	return db, nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -11,10 +12,81 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetByAddress returns a page of the txs involving an address, it fails if
+	// the address indexes are not enabled.
+	GetByAddress(AddressTxQuery) (*AddressTxPage, error)
+	// GetLogBlocks returns the heights of the blocks in the inclusive range
+	// that may contain logs matching the addresses and topics, and false if
+	// the range is not covered by the log index. A positive limit stops the
+	// lookup after limit+1 heights.
+	GetLogBlocks(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]int64, bool, error)
+	// GetBlockRoots returns nil if the roots of the block are not indexed.
+	GetBlockRoots(int64) (*BlockRoots, error)
+}
+
+// BlockRoots are the roots of the eth txs and receipts of a block, along with
+// the bloom of the receipt logs, as set in the eth header of the block.
+type BlockRoots struct {
+	TxRoot      common.Hash
+	ReceiptRoot common.Hash
+	Bloom       ethtypes.Bloom
+}
+
+// AddressTxRole is the set of roles of an address in an eth tx.
+type AddressTxRole uint8
+
+const (
+	// AddressTxRoleFrom is the role of the tx sender.
+	AddressTxRoleFrom AddressTxRole = 1 << iota
+	// AddressTxRoleTo is the role of the tx recipient.
+	AddressTxRoleTo
+	// AddressTxRoleCreated is the role of the contract created by the tx.
+	AddressTxRoleCreated
+)
+
+// AddressTxCursor is the position of an eth tx in the address indexes.
+type AddressTxCursor struct {
+	BlockNumber int64
+	TxIndex     int32
+}
+
+// AddressTxQuery is a paginated range query of the eth txs involving an address.
+type AddressTxQuery struct {
+	Address common.Address
+	// Roles filters the txs by the roles of the address, zero matches any role.
+	Roles AddressTxRole
+	// FromBlock and ToBlock are the inclusive block range of the query, a
+	// negative ToBlock doesn't bound the range.
+	FromBlock int64
+	ToBlock   int64
+	// Descending returns the most recent txs first.
+	Descending bool
+	// Cursor is the position of the first tx of the page, as returned by the
+	// previous page. The first page starts at the bound of the block range.
+	Cursor *AddressTxCursor
+	// Limit is the maximum number of txs of the page, zero means no limit.
+	Limit int
+}
+
+// AddressTx is an eth tx involving an address.
+type AddressTx struct {
+	Hash        common.Hash
+	BlockNumber int64
+	TxIndex     int32
+	Roles       AddressTxRole
+}
+
+// AddressTxPage is a page of the result of an AddressTxQuery.
+type AddressTxPage struct {
+	Txs []AddressTx
+	// Next is the cursor of the next page, nil on the last page.
+	Next *AddressTxCursor
 }
@@ -0,0 +1,30 @@
+package statemirror
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EVMKeeper defines the methods of the vm keeper reading the EVM state mirrored
+// by the state mirror.
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+}
+
+// AccountKeeper defines the methods of the auth keeper used to rebuild the
+// state mirror from the whole EVM state.
+type AccountKeeper interface {
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
+}
+
+// StateProvider returns a context holding the committed state of a height, it
+// is called by the worker of the state mirror to read the state it publishes.
+type StateProvider func(height int64) (sdk.Context, error)
@@ -0,0 +1,607 @@
+package statemirror
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// rebuildBatchSize is the number of accounts committed at once when the
+	// mirror is rebuilt from the whole EVM state.
+	rebuildBatchSize = 10_000
+	// queueSize is the number of committed blocks waiting for the worker of the
+	// mirror, the blocks committed while the queue is full are skipped and the
+	// mirror is rebuilt at the next published height.
+	queueSize = 256
+)
+
+var (
+	// headKey stores the root of the latest published state
+	headKey = []byte("statemirror-head")
+	// lastHeightKey stores the height of the last block recorded by the mirror
+	lastHeightKey = []byte("statemirror-last-height")
+	// rebuildKey is set when the mirror has to be rebuilt from the whole EVM state
+	rebuildKey = []byte("statemirror-rebuild")
+	// rootPrefix prefixes the roots of the published states: `height -> root`
+	rootPrefix = []byte("statemirror-root-")
+	// dirtyPrefix prefixes the accounts and storage slots changed since the
+	// latest published state: `address [| slot] -> nil`
+	dirtyPrefix = []byte("statemirror-dirty-")
+	// destructedPrefix prefixes the accounts self-destructed since the latest
+	// published state: `address -> nil`
+	destructedPrefix = []byte("statemirror-destructed-")
+)
+
+var (
+	_ storetypes.ABCIListener      = &StateMirror{}
+	_ evmtypes.StateCommitListener = &StateMirror{}
+)
+
+// finalizedBlock holds the changes of a finalized block.
+type finalizedBlock struct {
+	height int64
+	dirty  map[common.Address]*dirtyAccount
+}
+
+// dirtyAccount holds the changes of an account since the latest published state.
+type dirtyAccount struct {
+	destructed bool
+	slots      map[common.Hash]struct{}
+}
+
+// StateMirror maintains a Merkle-Patricia trie mirror of the EVM state, to serve
+// Ethereum-format account and storage proofs.
+//
+// The vm keeper notifies the mirror of the accounts and storage slots written by
+// the StateDB commits, and the balances and nonces changed by Cosmos messages are
+// collected from the bank and tx events of the block. Once a block is committed,
+// a background worker records its changes and, at the heights multiple of the
+// interval, reads their final values from the committed state of the height and
+// commits them to the trie. The root of the trie is then published for the
+// height, the roots older than the kept ones are pruned.
+//
+// The mirror is rebuilt from the whole EVM state when it misses blocks, e.g. when
+// it is enabled on a running chain, after a state sync or when the worker falls
+// behind by more than the queue size. The trie is stored with the hash scheme,
+// its nodes are shared by the published roots.
+type StateMirror struct {
+	db            ethdb.Database
+	triedb        *triedb.Database
+	stateDB       *state.CachingDB
+	interval      uint64
+	keepRoots     uint64
+	evmKeeper     EVMKeeper
+	accountKeeper AccountKeeper
+	stateAt       StateProvider
+	logger        log.Logger
+
+	// mtx guards the trie and the recorded blocks
+	mtx        sync.RWMutex
+	lastHeight int64
+	rebuild    bool
+
+	// dirtyMtx guards the changes of the block being finalized and of the
+	// finalized block waiting for its commit
+	dirtyMtx  sync.Mutex
+	dirty     map[common.Address]*dirtyAccount
+	finalized *finalizedBlock
+
+	// queue holds the committed blocks processed by the worker
+	queue   chan *finalizedBlock
+	pending sync.WaitGroup
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// OpenDB opens the db of the state mirror, next to the custom eth indexer db.
+func OpenDB(homeDir string) (ethdb.Database, error) {
+	kvdb, err := leveldb.New(filepath.Join(homeDir, "data", "evmstatemirror.db"), 0, 0, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return rawdb.NewDatabase(kvdb), nil
+}
+
+// NewStateMirror creates the state mirror stored in the given db, publishing a
+// state root every interval blocks and keeping the latest keepRoots roots, all
+// of them if zero. It starts the worker of the mirror, stopped by Close.
+func NewStateMirror(
+	db ethdb.Database,
+	interval uint64,
+	keepRoots uint64,
+	evmKeeper EVMKeeper,
+	accountKeeper AccountKeeper,
+	stateAt StateProvider,
+	logger log.Logger,
+) (*StateMirror, error) {
+	if interval == 0 {
+		return nil, fmt.Errorf("state mirror interval cannot be 0")
+	}
+
+	tdb := triedb.NewDatabase(db, triedb.HashDefaults)
+	m := &StateMirror{
+		db:            db,
+		triedb:        tdb,
+		stateDB:       state.NewDatabase(tdb, nil),
+		interval:      interval,
+		keepRoots:     keepRoots,
+		evmKeeper:     evmKeeper,
+		accountKeeper: accountKeeper,
+		stateAt:       stateAt,
+		logger:        logger,
+		dirty:         make(map[common.Address]*dirtyAccount),
+		queue:         make(chan *finalizedBlock, queueSize),
+		quit:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	if bz, err := db.Get(lastHeightKey); err == nil {
+		m.lastHeight = int64(sdk.BigEndianToUint64(bz)) //nolint:gosec // G115 // height is not negative
+	}
+	if has, err := db.Has(rebuildKey); err != nil {
+		return nil, err
+	} else if has {
+		m.rebuild = true
+	}
+
+	go m.run()
+	return m, nil
+}
+
+// Close stops the worker and closes the db of the state mirror, the committed
+// blocks still queued are skipped.
+func (m *StateMirror) Close() error {
+	close(m.quit)
+	<-m.done
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err := m.triedb.Close(); err != nil {
+		return err
+	}
+	return m.db.Close()
+}
+
+// OnAccountCommit implements evmtypes.StateCommitListener.
+func (m *StateMirror) OnAccountCommit(addr common.Address) {
+	m.dirtyMtx.Lock()
+	defer m.dirtyMtx.Unlock()
+
+	m.touch(addr)
+}
+
+// OnStorageCommit implements evmtypes.StateCommitListener.
+func (m *StateMirror) OnStorageCommit(addr common.Address, key common.Hash) {
+	m.dirtyMtx.Lock()
+	defer m.dirtyMtx.Unlock()
+
+	m.touch(addr).slots[key] = struct{}{}
+}
+
+// OnAccountDelete implements evmtypes.StateCommitListener.
+func (m *StateMirror) OnAccountDelete(addr common.Address) {
+	m.dirtyMtx.Lock()
+	defer m.dirtyMtx.Unlock()
+
+	m.touch(addr).destructed = true
+}
+
+// touch returns the changes of an account in the block being finalized, the
+// caller must hold dirtyMtx.
+func (m *StateMirror) touch(addr common.Address) *dirtyAccount {
+	account, ok := m.dirty[addr]
+	if !ok {
+		account = &dirtyAccount{slots: make(map[common.Hash]struct{})}
+		m.dirty[addr] = account
+	}
+	return account
+}
+
+// touchEventAccounts records the accounts whose balance or nonce is changed by
+// the bank transfers and the Cosmos txs of the events.
+func (m *StateMirror) touchEventAccounts(events []abci.Event) {
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		case sdk.EventTypeTx:
+			key = sdk.AttributeKeyAccountSequence
+		default:
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != key {
+				continue
+			}
+			// the account sequences are formatted as address/sequence
+			bech32, _, _ := strings.Cut(attr.Value, "/")
+			addr, err := sdk.AccAddressFromBech32(bech32)
+			if err != nil || len(addr) != common.AddressLength {
+				continue
+			}
+			m.OnAccountCommit(common.BytesToAddress(addr))
+		}
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener. It collects the
+// changes of the finalized block, they are queued for the worker once the block
+// is committed.
+func (m *StateMirror) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	for _, txResult := range res.TxResults {
+		m.touchEventAccounts(txResult.Events)
+	}
+	m.touchEventAccounts(res.Events)
+
+	m.dirtyMtx.Lock()
+	defer m.dirtyMtx.Unlock()
+
+	m.finalized = &finalizedBlock{height: req.Height, dirty: m.dirty}
+	m.dirty = make(map[common.Address]*dirtyAccount)
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It queues the changes of the
+// committed block for the worker, the block is skipped if the queue is full.
+func (m *StateMirror) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	m.dirtyMtx.Lock()
+	block := m.finalized
+	m.finalized = nil
+	m.dirtyMtx.Unlock()
+
+	if block == nil {
+		return nil
+	}
+
+	m.pending.Add(1)
+	select {
+	case m.queue <- block:
+	default:
+		m.pending.Done()
+		m.logger.Error("state mirror queue is full, skipping block", "height", block.height)
+	}
+	return nil
+}
+
+// run processes the committed blocks until the mirror is closed.
+func (m *StateMirror) run() {
+	defer close(m.done)
+
+	for {
+		select {
+		case <-m.quit:
+			return
+		case block := <-m.queue:
+			if err := m.processBlock(block); err != nil {
+				m.logger.Error("failed to process block", "height", block.height, "error", err.Error())
+			}
+			m.pending.Done()
+		}
+	}
+}
+
+// processBlock records the changes of a committed block and publishes the state
+// root at the heights multiple of the interval.
+func (m *StateMirror) processBlock(block *finalizedBlock) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// the changes of the block are only enough to update the mirror if the
+	// previous block is recorded, the block can also be committed again after
+	// a restart if it was not recorded
+	if m.lastHeight == 0 || (block.height != m.lastHeight && block.height != m.lastHeight+1) {
+		m.rebuild = true
+	}
+
+	batch := m.db.NewBatch()
+	if m.rebuild {
+		if err := batch.Put(rebuildKey, []byte{1}); err != nil {
+			return err
+		}
+	} else if err := writeDirty(batch, block.dirty); err != nil {
+		return err
+	}
+	if err := batch.Put(lastHeightKey, sdk.Uint64ToBigEndian(uint64(block.height))); err != nil { //nolint:gosec // G115 // height is not negative
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	m.lastHeight = block.height
+
+	if uint64(block.height)%m.interval != 0 { //nolint:gosec // G115 // height is not negative
+		return nil
+	}
+
+	err := m.publish(block.height)
+	if err != nil {
+		// the mirror is rebuilt at the next published height
+		m.rebuild = true
+		if err := m.db.Put(rebuildKey, []byte{1}); err != nil {
+			m.logger.Error("failed to schedule the rebuild of the state mirror", "error", err.Error())
+		}
+		return fmt.Errorf("failed to publish the state mirror root at height %d: %w", block.height, err)
+	}
+	return nil
+}
+
+// publish commits the recorded changes to the trie, or rebuilds it, and
+// publishes its root for the height. The caller must hold mtx.
+func (m *StateMirror) publish(height int64) error {
+	ctx, err := m.stateAt(height)
+	if err != nil {
+		return err
+	}
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var root common.Hash
+	if m.rebuild {
+		root, err = m.rebuildState(ctx, height)
+	} else {
+		root, err = m.updateState(ctx, height)
+	}
+	if err != nil {
+		return err
+	}
+	if err := m.triedb.Commit(root, false); err != nil {
+		return err
+	}
+
+	batch := m.db.NewBatch()
+	for _, prefix := range [][]byte{dirtyPrefix, destructedPrefix} {
+		it := m.db.NewIterator(prefix, nil)
+		for it.Next() {
+			if err := batch.Delete(it.Key()); err != nil {
+				it.Release()
+				return err
+			}
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			return err
+		}
+	}
+	if err := batch.Put(RootKey(height), root.Bytes()); err != nil {
+		return err
+	}
+	if err := batch.Put(headKey, root.Bytes()); err != nil {
+		return err
+	}
+	if err := batch.Delete(rebuildKey); err != nil {
+		return err
+	}
+	if err := m.pruneRoots(batch, height); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	m.rebuild = false
+	m.logger.Debug("published state root", "height", height, "root", root.Hex())
+	return nil
+}
+
+// pruneRoots deletes the published roots older than the kept ones into the db
+// batch.
+func (m *StateMirror) pruneRoots(batch ethdb.Batch, height int64) error {
+	retained := m.keepRoots * m.interval
+	if m.keepRoots == 0 || uint64(height) < retained { //nolint:gosec // G115 // height is not negative
+		return nil
+	}
+	// the roots are ordered by height in the db
+	end := RootKey(height - int64(retained) + 1) //nolint:gosec // G115 // retained is lower than height
+
+	it := m.db.NewIterator(rootPrefix, nil)
+	defer it.Release()
+	for it.Next() && bytes.Compare(it.Key(), end) < 0 {
+		if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// updateState applies the final values of the accounts and storage slots
+// changed since the latest published state to its trie.
+func (m *StateMirror) updateState(ctx sdk.Context, height int64) (common.Hash, error) {
+	parent := ethtypes.EmptyRootHash
+	if bz, err := m.db.Get(headKey); err == nil {
+		parent = common.BytesToHash(bz)
+	}
+	sdb, err := state.New(parent, m.stateDB)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	dirty, err := m.readDirty()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// the deleted and self-destructed accounts are removed along with their
+	// storage first, the latter are then created again from the EVM state
+	accounts := make(map[common.Address]*statedb.Account, len(dirty))
+	for addr, changes := range dirty {
+		accounts[addr] = m.evmKeeper.GetAccount(ctx, addr)
+		if accounts[addr] == nil || changes.destructed {
+			sdb.SelfDestruct(addr)
+		}
+	}
+	sdb.Finalise(true)
+
+	for addr, changes := range dirty {
+		account := accounts[addr]
+		if account == nil {
+			continue
+		}
+		m.setAccount(ctx, sdb, addr, account)
+		if changes.destructed {
+			m.setStorage(ctx, sdb, addr)
+			continue
+		}
+		for key := range changes.slots {
+			sdb.SetState(addr, key, m.evmKeeper.GetState(ctx, addr, key))
+		}
+	}
+	return sdb.Commit(uint64(height), true, false) //nolint:gosec // G115 // height is not negative
+}
+
+// rebuildState builds the trie of the whole EVM state, it is committed every
+// rebuildBatchSize accounts to bound the memory usage.
+func (m *StateMirror) rebuildState(ctx sdk.Context, height int64) (common.Hash, error) {
+	m.logger.Info("rebuilding the state mirror from the EVM state", "height", height)
+
+	root := ethtypes.EmptyRootHash
+	sdb, err := state.New(root, m.stateDB)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	var count int
+	m.accountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) (stop bool) {
+		if len(acc.GetAddress()) != common.AddressLength {
+			return false
+		}
+		addr := common.BytesToAddress(acc.GetAddress())
+		account := m.evmKeeper.GetAccount(ctx, addr)
+		if account == nil {
+			return false
+		}
+		m.setAccount(ctx, sdb, addr, account)
+		m.setStorage(ctx, sdb, addr)
+
+		count++
+		if count%rebuildBatchSize != 0 {
+			return false
+		}
+		if root, err = sdb.Commit(uint64(height), true, false); err != nil { //nolint:gosec // G115 // height is not negative
+			return true
+		}
+		if err = m.triedb.Commit(root, false); err != nil {
+			return true
+		}
+		sdb, err = state.New(root, m.stateDB)
+		return err != nil
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	m.logger.Info("rebuilt the state mirror from the EVM state", "height", height, "accounts", count)
+	return sdb.Commit(uint64(height), true, false) //nolint:gosec // G115 // height is not negative
+}
+
+// setAccount sets the nonce, balance and code of an account in the trie.
+func (m *StateMirror) setAccount(ctx sdk.Context, sdb *state.StateDB, addr common.Address, account *statedb.Account) {
+	balance := account.Balance
+	if balance == nil {
+		balance = new(uint256.Int)
+	}
+	sdb.SetNonce(addr, account.Nonce, tracing.NonceChangeUnspecified)
+	sdb.SetBalance(addr, balance, tracing.BalanceChangeUnspecified)
+
+	codeHash := common.BytesToHash(account.CodeHash)
+	if sdb.GetCodeHash(addr) == codeHash {
+		return
+	}
+	var code []byte
+	if !evmtypes.IsEmptyCodeHash(account.CodeHash) {
+		code = m.evmKeeper.GetCode(ctx, codeHash)
+	}
+	sdb.SetCode(addr, code)
+}
+
+// setStorage sets the whole storage of an account in the trie.
+func (m *StateMirror) setStorage(ctx sdk.Context, sdb *state.StateDB, addr common.Address) {
+	m.evmKeeper.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		sdb.SetState(addr, key, value)
+		return true
+	})
+}
+
+// readDirty reads the changes recorded since the latest published state.
+func (m *StateMirror) readDirty() (map[common.Address]*dirtyAccount, error) {
+	dirty := make(map[common.Address]*dirtyAccount)
+	touch := func(addr common.Address) *dirtyAccount {
+		if _, ok := dirty[addr]; !ok {
+			dirty[addr] = &dirtyAccount{slots: make(map[common.Hash]struct{})}
+		}
+		return dirty[addr]
+	}
+
+	it := m.db.NewIterator(dirtyPrefix, nil)
+	for it.Next() {
+		key := it.Key()[len(dirtyPrefix):]
+		account := touch(common.BytesToAddress(key[:common.AddressLength]))
+		if len(key) > common.AddressLength {
+			account.slots[common.BytesToHash(key[common.AddressLength:])] = struct{}{}
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	it = m.db.NewIterator(destructedPrefix, nil)
+	for it.Next() {
+		touch(common.BytesToAddress(it.Key()[len(destructedPrefix):])).destructed = true
+	}
+	it.Release()
+	return dirty, it.Error()
+}
+
+// writeDirty records the changes of a block into the db batch.
+func writeDirty(batch ethdb.Batch, dirty map[common.Address]*dirtyAccount) error {
+	for addr, account := range dirty {
+		if err := batch.Put(append(common.CopyBytes(dirtyPrefix), addr.Bytes()...), nil); err != nil {
+			return err
+		}
+		if account.destructed {
+			if err := batch.Put(append(common.CopyBytes(destructedPrefix), addr.Bytes()...), nil); err != nil {
+				return err
+			}
+		}
+		for key := range account.slots {
+			dbKey := append(append(common.CopyBytes(dirtyPrefix), addr.Bytes()...), key.Bytes()...)
+			if err := batch.Put(dbKey, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RootKey returns the key for db entry: `height -> state root`
+func RootKey(height int64) []byte {
+	return append(common.CopyBytes(rootPrefix), sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // height is not negative
+}
@@ -0,0 +1,313 @@
+package statemirror
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockEVMState is an in-memory EVM state implementing the keepers read by the
+// state mirror.
+type mockEVMState struct {
+	accounts map[common.Address]*statedb.Account
+	storage  map[common.Address]map[common.Hash]common.Hash
+	code     map[common.Hash][]byte
+}
+
+func newMockEVMState() *mockEVMState {
+	return &mockEVMState{
+		accounts: make(map[common.Address]*statedb.Account),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+		code:     make(map[common.Hash][]byte),
+	}
+}
+
+func (s *mockEVMState) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	return s.accounts[addr]
+}
+
+func (s *mockEVMState) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return s.storage[addr][key]
+}
+
+func (s *mockEVMState) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	return s.code[codeHash]
+}
+
+func (s *mockEVMState) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	for key, value := range s.storage[addr] {
+		if !cb(key, value) {
+			return
+		}
+	}
+}
+
+func (s *mockEVMState) IterateAccounts(_ context.Context, cb func(account sdk.AccountI) (stop bool)) {
+	for addr := range s.accounts {
+		if cb(authtypes.NewBaseAccountWithAddress(addr.Bytes())) {
+			return
+		}
+	}
+}
+
+func (s *mockEVMState) setAccount(addr common.Address, nonce, balance uint64, code []byte) {
+	codeHash := ethtypes.EmptyCodeHash
+	if len(code) > 0 {
+		codeHash = crypto.Keccak256Hash(code)
+		s.code[codeHash] = code
+	}
+	s.accounts[addr] = &statedb.Account{Nonce: nonce, Balance: uint256.NewInt(balance), CodeHash: codeHash.Bytes()}
+}
+
+func (s *mockEVMState) setState(addr common.Address, key, value common.Hash) {
+	if s.storage[addr] == nil {
+		s.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	if value == (common.Hash{}) {
+		delete(s.storage[addr], key)
+		return
+	}
+	s.storage[addr][key] = value
+}
+
+// root computes the state root of the whole EVM state from scratch.
+func (s *mockEVMState) root(t *testing.T) common.Hash {
+	t.Helper()
+	sdb, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	for addr, account := range s.accounts {
+		sdb.SetNonce(addr, account.Nonce, tracing.NonceChangeUnspecified)
+		sdb.SetBalance(addr, account.Balance, tracing.BalanceChangeUnspecified)
+		sdb.SetCode(addr, s.code[common.BytesToHash(account.CodeHash)])
+		for key, value := range s.storage[addr] {
+			sdb.SetState(addr, key, value)
+		}
+	}
+	root, err := sdb.Commit(0, true, false)
+	require.NoError(t, err)
+	return root
+}
+
+// verifyProof verifies a merkle proof and returns the proven value.
+func verifyProof(t *testing.T, root common.Hash, key []byte, proof []string) []byte {
+	t.Helper()
+	proofDB := memorydb.New()
+	for _, node := range proof {
+		bz := hexutil.MustDecode(node)
+		require.NoError(t, proofDB.Put(crypto.Keccak256(bz), bz))
+	}
+	value, err := trie.VerifyProof(root, crypto.Keccak256(key), proofDB)
+	require.NoError(t, err)
+	return value
+}
+
+// verifyAccountResult verifies the proofs of an account result against a root.
+func verifyAccountResult(t *testing.T, root common.Hash, res *rpctypes.AccountResult) {
+	t.Helper()
+	value := verifyProof(t, root, res.Address.Bytes(), res.AccountProof)
+	if value == nil {
+		require.Equal(t, ethtypes.EmptyRootHash, res.StorageHash)
+		require.Equal(t, ethtypes.EmptyCodeHash, res.CodeHash)
+	} else {
+		expAccount, err := rlp.EncodeToBytes(&ethtypes.StateAccount{
+			Nonce:    uint64(res.Nonce),
+			Balance:  uint256.MustFromBig(res.Balance.ToInt()),
+			Root:     res.StorageHash,
+			CodeHash: res.CodeHash.Bytes(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, expAccount, value)
+	}
+
+	for _, storage := range res.StorageProof {
+		if res.StorageHash == ethtypes.EmptyRootHash {
+			require.Empty(t, storage.Proof)
+			require.Zero(t, storage.Value.ToInt().Sign())
+			continue
+		}
+		value := verifyProof(t, res.StorageHash, common.HexToHash(storage.Key).Bytes(), storage.Proof)
+		var expValue []byte
+		if storage.Value.ToInt().Sign() != 0 {
+			expValue, _ = rlp.EncodeToBytes(storage.Value.ToInt().Bytes())
+		}
+		require.Equal(t, expValue, value)
+	}
+}
+
+// newTestStateMirror creates a state mirror of the mock EVM state stored in
+// memory, it is closed at the end of the test.
+func newTestStateMirror(t *testing.T, interval, keepRoots uint64, evmState *mockEVMState) *StateMirror {
+	t.Helper()
+	stateAt := func(height int64) (sdk.Context, error) {
+		return sdk.Context{}.WithBlockHeight(height), nil
+	}
+	mirror, err := NewStateMirror(rawdb.NewMemoryDatabase(), interval, keepRoots, evmState, evmState, stateAt, log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, mirror.Close()) })
+	return mirror
+}
+
+// commitBlock finalizes and commits a block with the given events and waits
+// for the worker of the mirror to process it.
+func commitBlock(t *testing.T, mirror *StateMirror, height int64, events ...abci.Event) {
+	t.Helper()
+	res := abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{Events: events}}}
+	require.NoError(t, mirror.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, res))
+	require.NoError(t, mirror.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	mirror.pending.Wait()
+}
+
+func TestStateMirror(t *testing.T) {
+	var (
+		eoa      = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		contract = common.HexToAddress("0x2222222222222222222222222222222222222222")
+		created  = common.HexToAddress("0x3333333333333333333333333333333333333333")
+		slot1    = common.HexToHash("0x01")
+		slot2    = common.HexToHash("0x02")
+		slot3    = common.HexToHash("0x03")
+	)
+
+	evmState := newMockEVMState()
+	evmState.setAccount(eoa, 1, 1000, nil)
+	evmState.setAccount(contract, 1, 0, []byte{0x60, 0x00})
+	evmState.setState(contract, slot1, common.HexToHash("0x05"))
+	evmState.setState(contract, slot2, common.HexToHash("0x07"))
+
+	mirror := newTestStateMirror(t, 2, 0, evmState)
+	finalize := func(height int64, events ...abci.Event) {
+		commitBlock(t, mirror, height, events...)
+	}
+
+	// the first published root is built from the whole EVM state
+	finalize(1)
+	_, ok := mirror.StateRoot(1)
+	require.False(t, ok)
+	_, err := mirror.GetProof(1, eoa, nil)
+	require.ErrorIs(t, err, ErrNoStateRoot)
+
+	finalize(2)
+	root2, ok := mirror.StateRoot(2)
+	require.True(t, ok)
+	require.Equal(t, evmState.root(t), root2)
+
+	res, err := mirror.GetProof(2, contract, []string{slot1.Hex(), slot3.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), uint64(res.Nonce))
+	require.Equal(t, crypto.Keccak256Hash([]byte{0x60, 0x00}), res.CodeHash)
+	require.Equal(t, int64(5), res.StorageProof[0].Value.ToInt().Int64())
+	require.Zero(t, res.StorageProof[1].Value.ToInt().Sign())
+	verifyAccountResult(t, root2, res)
+
+	// the changes notified by the keeper and the bank events are applied to the
+	// latest published state
+	evmState.setState(contract, slot1, common.HexToHash("0x09"))
+	evmState.setState(contract, slot2, common.Hash{})
+	mirror.OnStorageCommit(contract, slot1)
+	mirror.OnStorageCommit(contract, slot2)
+	finalize(3)
+
+	evmState.setAccount(created, 0, 0, []byte{0x60, 0x01})
+	evmState.setState(created, slot3, common.HexToHash("0x01"))
+	mirror.OnAccountCommit(created)
+	mirror.OnStorageCommit(created, slot3)
+	evmState.accounts[eoa].Balance = uint256.NewInt(400)
+	finalize(4, abci.Event{
+		Type:       banktypes.EventTypeCoinSpent,
+		Attributes: []abci.EventAttribute{{Key: banktypes.AttributeKeySpender, Value: sdk.AccAddress(eoa.Bytes()).String()}},
+	})
+
+	root4, ok := mirror.StateRoot(4)
+	require.True(t, ok)
+	require.Equal(t, evmState.root(t), root4)
+
+	res, err = mirror.GetProof(4, eoa, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(400), res.Balance.ToInt().Int64())
+	verifyAccountResult(t, root4, res)
+
+	res, err = mirror.GetProof(4, contract, []string{slot1.Hex(), slot2.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, int64(9), res.StorageProof[0].Value.ToInt().Int64())
+	require.Zero(t, res.StorageProof[1].Value.ToInt().Sign())
+	verifyAccountResult(t, root4, res)
+
+	// the previous published states are still served
+	res, err = mirror.GetProof(2, created, []string{slot3.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.EmptyRootHash, res.StorageHash)
+	verifyAccountResult(t, root2, res)
+
+	// a self-destructed account is removed with its storage
+	delete(evmState.accounts, created)
+	delete(evmState.storage, created)
+	mirror.OnAccountDelete(created)
+	finalize(5)
+	finalize(6)
+
+	root6, ok := mirror.StateRoot(6)
+	require.True(t, ok)
+	require.Equal(t, evmState.root(t), root6)
+	res, err = mirror.GetProof(6, created, []string{slot3.Hex()})
+	require.NoError(t, err)
+	require.Zero(t, uint64(res.Nonce))
+	verifyAccountResult(t, root6, res)
+
+	// the mirror is rebuilt after missing blocks, the changes it missed are
+	// not notified
+	evmState.setState(contract, slot2, common.HexToHash("0x0a"))
+	finalize(9)
+	finalize(10)
+
+	root10, ok := mirror.StateRoot(10)
+	require.True(t, ok)
+	require.Equal(t, evmState.root(t), root10)
+}
+
+func TestStateMirrorPruneRoots(t *testing.T) {
+	eoa := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	evmState := newMockEVMState()
+	evmState.setAccount(eoa, 1, 1000, nil)
+
+	mirror := newTestStateMirror(t, 2, 2, evmState)
+	for height := int64(1); height <= 8; height++ {
+		commitBlock(t, mirror, height)
+	}
+
+	// only the latest 2 published roots are kept
+	for _, height := range []int64{2, 4} {
+		_, ok := mirror.StateRoot(height)
+		require.False(t, ok)
+		_, err := mirror.GetProof(height, eoa, nil)
+		require.ErrorIs(t, err, ErrNoStateRoot)
+	}
+	for _, height := range []int64{6, 8} {
+		root, ok := mirror.StateRoot(height)
+		require.True(t, ok)
+		res, err := mirror.GetProof(height, eoa, nil)
+		require.NoError(t, err)
+		verifyAccountResult(t, root, res)
+	}
+}
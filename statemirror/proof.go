@@ -0,0 +1,109 @@
+package statemirror
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// ErrNoStateRoot is returned for the proofs at the heights without a state root
+// published by the state mirror, or whose root is pruned.
+var ErrNoStateRoot = errors.New("no state root published by the state mirror")
+
+// proofList collects the trie nodes of a proof in their hex encoding.
+type proofList []string
+
+func (n *proofList) Put(_ []byte, value []byte) error {
+	*n = append(*n, hexutil.Encode(value))
+	return nil
+}
+
+func (n *proofList) Delete([]byte) error {
+	panic("not supported")
+}
+
+// StateRoot returns the state root published for the height, returns false if
+// no state root is published for the height.
+func (m *StateMirror) StateRoot(height int64) (common.Hash, bool) {
+	bz, err := m.db.Get(RootKey(height))
+	if err != nil || len(bz) != common.HashLength {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// GetProof returns the EIP-1186 proofs of an account and its storage slots
+// against the state root published for the height, the same as geth. It returns
+// ErrNoStateRoot if no state root is published for the height.
+func (m *StateMirror) GetProof(height int64, address common.Address, storageKeys []string) (*rpctypes.AccountResult, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	root, ok := m.StateRoot(height)
+	if !ok {
+		return nil, ErrNoStateRoot
+	}
+	sdb, err := state.New(root, m.stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := sdb.GetCodeHash(address)
+	if codeHash == (common.Hash{}) {
+		codeHash = ethtypes.EmptyCodeHash
+	}
+	storageRoot := sdb.GetStorageRoot(address)
+	if storageRoot == (common.Hash{}) {
+		storageRoot = ethtypes.EmptyRootHash
+	}
+
+	var storageTrie *trie.StateTrie
+	if storageRoot != ethtypes.EmptyRootHash && len(storageKeys) > 0 {
+		id := trie.StorageTrieID(root, crypto.Keccak256Hash(address.Bytes()), storageRoot)
+		if storageTrie, err = trie.NewStateTrie(id, m.triedb); err != nil {
+			return nil, err
+		}
+	}
+
+	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		slot := common.HexToHash(key)
+		proof := proofList{}
+		if storageTrie != nil {
+			if err := storageTrie.Prove(crypto.Keccak256(slot.Bytes()), &proof); err != nil {
+				return nil, err
+			}
+		}
+		storageProofs[i] = rpctypes.StorageResult{
+			Key:   key,
+			Value: (*hexutil.Big)(sdb.GetState(address, slot).Big()),
+			Proof: proof,
+		}
+	}
+
+	accountTrie, err := trie.NewStateTrie(trie.StateTrieID(root), m.triedb)
+	if err != nil {
+		return nil, err
+	}
+	accountProof := proofList{}
+	if err := accountTrie.Prove(crypto.Keccak256(address.Bytes()), &accountProof); err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccountResult{
+		Address:      address,
+		AccountProof: accountProof,
+		Balance:      (*hexutil.Big)(sdb.GetBalance(address).ToBig()),
+		CodeHash:     codeHash,
+		Nonce:        hexutil.Uint64(sdb.GetNonce(address)),
+		StorageHash:  storageRoot,
+		StorageProof: storageProofs,
+	}, nil
+}
@@ -121,7 +121,9 @@ func (s *AnteTestSuite) SetupTest() {
 
 	configurator := evmtypes.NewEVMConfigurator()
 	configurator.ResetTestConfig()
-	err := configurator.
+	err := evmtypes.SetChainConfig(chainConfig)
+	s.Require().NoError(err)
+	err = configurator.
 		WithEVMCoinInfo(evmtypes.EvmCoinInfo{
 			Denom:         denom,
 			ExtendedDenom: extendedDenom,
@@ -0,0 +1,195 @@
+package ante
+
+import (
+	"math/big"
+	"testing"
+
+	basefactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RunAnteHandlerWithFeeGranterTest runs the fee granter tests of the EVM ante
+// handler.
+//
+//nolint:thelper // RunAnteHandlerWithFeeGranterTest is not a helper function; it's an externally called test entry point
+func RunAnteHandlerWithFeeGranterTest(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	s := NewEvmAnteTestSuite(create, options...)
+	s.SetT(t)
+	s.TestAnteHandlerWithFeeGranter()
+	s.TestFeeGranterRefund()
+}
+
+func (s *EvmAnteTestSuite) TestAnteHandlerWithFeeGranter() {
+	gasLimit := uint64(100_000)
+	gasPrice := big.NewInt(150)
+	fee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit)))
+
+	testCases := []struct {
+		name        string
+		amount      *big.Int
+		spendLimit  sdkmath.Int
+		grant       bool
+		notTo       bool
+		errContains string
+	}{
+		{
+			name:        "fail - fee granter is not the recipient",
+			amount:      big.NewInt(0),
+			spendLimit:  fee.MulRaw(2),
+			grant:       true,
+			notTo:       true,
+			errContains: "should be the recipient of the tx",
+		},
+		{
+			name:        "fail - no fee allowance",
+			amount:      big.NewInt(0),
+			errContains: "does not allow to pay fees",
+		},
+		{
+			name:        "fail - spend limit exceeded",
+			amount:      big.NewInt(0),
+			spendLimit:  fee.SubRaw(1),
+			grant:       true,
+			errContains: "does not allow to pay fees",
+		},
+		{
+			name:        "fail - sender cannot cover the value",
+			amount:      big.NewInt(10),
+			spendLimit:  fee,
+			grant:       true,
+			errContains: "sender balance < tx value",
+		},
+		{
+			name:       "success - fees paid by the granter",
+			amount:     big.NewInt(0),
+			spendLimit: fee.MulRaw(2),
+			grant:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.WithFeemarketEnabled(true)
+			s.WithLondonHardForkEnabled(true)
+			baseFee := sdkmath.LegacyNewDec(100)
+			s.WithBaseFee(&baseFee)
+			s.SetupTest() // reset
+
+			ctx := s.GetNetwork().GetContext()
+			app := s.GetNetwork().App
+			denom := evmtypes.GetEVMCoinExtendedDenom()
+			granter := s.GetKeyring().GetKey(1)
+			// the sender has no funds to pay for the fees
+			sender, senderPriv := utiltx.NewAddrKey()
+
+			if tc.grant {
+				err := app.GetFeeGrantKeeper().GrantAllowance(ctx, granter.AccAddr, sender.Bytes(), &feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(denom, tc.spendLimit)),
+				})
+				s.Require().NoError(err)
+			}
+
+			// the granter sponsors the calls made to it
+			to := granter.Addr
+			if tc.notTo {
+				to = utiltx.GenerateAddress()
+			}
+			txBuilder := s.CreateTxBuilder(senderPriv, evmtypes.EvmTxArgs{
+				To:       &to,
+				Amount:   tc.amount,
+				GasLimit: gasLimit,
+				GasPrice: gasPrice,
+			})
+			txBuilder.SetFeeGranter(granter.AccAddr)
+
+			granterBalance := app.GetBankKeeper().GetBalance(ctx, granter.AccAddr, denom)
+			_, err := s.GetAnteHandler()(ctx, txBuilder.GetTx(), false)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the fees are deducted from the granter balance and allowance
+			newGranterBalance := app.GetBankKeeper().GetBalance(ctx, granter.AccAddr, denom)
+			s.Require().Equal(granterBalance.Amount.Sub(fee), newGranterBalance.Amount)
+
+			allowance, err := app.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, sender.Bytes())
+			s.Require().NoError(err)
+			basic, ok := allowance.(*feegrant.BasicAllowance)
+			s.Require().True(ok)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, fee)), basic.SpendLimit)
+
+			// the leftover gas is refunded to the granter
+			s.Require().Equal(granter.AccAddr, app.GetEVMKeeper().GetFeeGranterTransient(ctx))
+		})
+	}
+}
+
+// TestFeeGranterRefund tests that the leftover gas of a fee-granted transaction
+// is refunded to the granter balance, while the fee allowance stays used up for
+// the gas limit.
+func (s *EvmAnteTestSuite) TestFeeGranterRefund() {
+	s.WithFeemarketEnabled(true)
+	s.WithLondonHardForkEnabled(true)
+	baseFee := sdkmath.LegacyNewDec(100)
+	s.WithBaseFee(&baseFee)
+	s.SetupTest() // reset
+
+	gasLimit := uint64(100_000)
+	gasPrice := big.NewInt(150)
+	fee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit)))
+	spendLimit := fee.MulRaw(2)
+
+	nw := s.GetNetwork()
+	app := nw.App
+	denom := evmtypes.GetEVMCoinExtendedDenom()
+	granter := s.GetKeyring().GetKey(1)
+	// the sender has no funds to pay for the fees
+	sender, senderPriv := utiltx.NewAddrKey()
+
+	msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin(denom, spendLimit)),
+	}, granter.AccAddr, sender.Bytes())
+	s.Require().NoError(err)
+	_, err = s.GetTxFactory().CommitCosmosTx(granter.Priv, basefactory.CosmosTxArgs{Msgs: []sdk.Msg{msg}})
+	s.Require().NoError(err)
+
+	to := granter.Addr
+	txBuilder := s.CreateTxBuilder(senderPriv, evmtypes.EvmTxArgs{
+		To:       &to,
+		Amount:   big.NewInt(0),
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+	})
+	txBuilder.SetFeeGranter(granter.AccAddr)
+	txBytes, err := s.GetClientCtx().TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	granterBalance := app.GetBankKeeper().GetBalance(nw.GetContext(), granter.AccAddr, denom)
+	res, err := nw.NextBlockWithTxs(txBytes)
+	s.Require().NoError(err)
+	s.Require().Len(res.TxResults, 1)
+	s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	gasUsed := uint64(res.TxResults[0].GasUsed) //#nosec G115 -- the gas used is positive
+	s.Require().Less(gasUsed, gasLimit)
+
+	// the granter only pays for the gas used
+	usedFee := sdkmath.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed)))
+	newGranterBalance := app.GetBankKeeper().GetBalance(nw.GetContext(), granter.AccAddr, denom)
+	s.Require().Equal(granterBalance.Amount.Sub(usedFee), newGranterBalance.Amount)
+
+	// the allowance is used up for the gas limit
+	allowance, err := app.GetFeeGrantKeeper().GetAllowance(nw.GetContext(), granter.AccAddr, sender.Bytes())
+	s.Require().NoError(err)
+	basic, ok := allowance.(*feegrant.BasicAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, spendLimit.Sub(fee))), basic.SpendLimit)
+}
@@ -2,15 +2,21 @@ package ante
 
 import (
 	"fmt"
+	"testing"
 
+	"github.com/cosmos/evm/ante"
 	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	antetypes "github.com/cosmos/evm/ante/types"
 	"github.com/cosmos/evm/testutil"
 	"github.com/cosmos/evm/testutil/constants"
+	basefactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testutiltx "github.com/cosmos/evm/testutil/tx"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -192,7 +198,7 @@ func (s *AnteTestSuite) TestMinGasPriceDecorator() {
 			s.Run(et.name+"_"+tc.name, func() {
 				ctx := ctx.WithIsReCheckTx(et.isCheckTx)
 				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
-				dec := cosmosante.NewMinGasPriceDecorator(&params)
+				dec := cosmosante.NewMinGasPriceDecorator(&params, nil)
 				_, err := dec.AnteHandle(ctx, tc.malleate(), et.simulate, testutil.NoOpNextFn)
 
 				if (et.name == "deliverTx" && tc.expPass) || (et.name == "deliverTxSimulate" && et.simulate && tc.allowPassOnSimulate) {
@@ -205,3 +211,145 @@ func (s *AnteTestSuite) TestMinGasPriceDecorator() {
 		}
 	}
 }
+
+// mockFeeDenomOracle values a unit of the fee denom as twice a unit of the EVM denom.
+type mockFeeDenomOracle struct {
+	denom string
+}
+
+func (o mockFeeDenomOracle) ConvertToEVMDenom(_ sdk.Context, coin sdk.Coin) (math.Int, bool) {
+	if coin.Denom != o.denom {
+		return math.Int{}, false
+	}
+	return coin.Amount.MulRaw(2), true
+}
+
+func (s *AnteTestSuite) TestMinGasPriceDecoratorFeeDenomOracle() {
+	feeDenom := "uusdc"
+	testMsg := banktypes.MsgSend{
+		FromAddress: "cosmos1x8fhpj9nmhqk8z9kpgjt95ck2xwyue0ptzkucp",
+		ToAddress:   "cosmos1dx67l23hz9l0k9hcher8xz04uj7wf3yu26l2yn",
+		Amount:      sdk.Coins{sdk.Coin{Amount: math.NewInt(10), Denom: constants.ExampleAttoDenom}},
+	}
+	nw := s.GetNetwork()
+	ctx := nw.GetContext()
+
+	params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+	params.MinGasPrice = math.LegacyNewDec(10)
+	dec := cosmosante.NewMinGasPriceDecorator(&params, mockFeeDenomOracle{denom: feeDenom})
+
+	testCases := []struct {
+		name     string
+		gasPrice math.Int
+		denom    string
+		errMsg   string
+	}{
+		{"valid fee in oracle denom", math.NewInt(5), feeDenom, ""},
+		{"insufficient fee in oracle denom", math.NewInt(4), feeDenom, "provided fee < minimum global fee"},
+		{"valid fee in EVM denom", math.NewInt(10), constants.ExampleAttoDenom, ""},
+		{"fee in unknown denom", math.NewInt(100), "uatom", "expected only native token"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txBuilder := s.CreateTestCosmosTxBuilder(tc.gasPrice, tc.denom, &testMsg)
+			_, err := dec.AnteHandle(ctx, txBuilder.GetTx(), false, testutil.NoOpNextFn)
+			if tc.errMsg == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+// RunFeeDenomOracleTest runs the tests of the Cosmos tx fees paid in the denoms
+// accepted by a fee denom oracle.
+//
+//nolint:thelper // RunFeeDenomOracleTest is not a helper function; it's an externally called test entry point
+func RunFeeDenomOracleTest(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	s := NewAnteTestSuite(create, options...)
+	s.WithFeemarketEnabled(true)
+	s.WithLondonHardForkEnabled(true)
+	s.SetT(t)
+	s.SetupTest()
+	s.TestMinGasPriceDecoratorFeeDenomOracle()
+	s.TestAnteHandlerFeeDenomOracle()
+}
+
+func (s *AnteTestSuite) TestAnteHandlerFeeDenomOracle() {
+	feeDenom := "uusdc"
+	gasLimit := uint64(200_000)
+
+	testCases := []struct {
+		name     string
+		gasPrice func(baseFee math.Int) math.Int
+		errMsg   string
+	}{
+		{
+			"pass - the whole fee is deducted in the oracle denom",
+			func(baseFee math.Int) math.Int { return baseFee.QuoRaw(2) },
+			"",
+		},
+		{
+			"fail - fee in the oracle denom below the base fee",
+			func(baseFee math.Int) math.Int { return baseFee.QuoRaw(2).SubRaw(1) },
+			"gas prices too low",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			nw := s.GetNetwork()
+			ctx := nw.GetContext()
+			sender := s.keyring.GetKey(0)
+			bankKeeper := nw.App.GetBankKeeper()
+			s.Require().NoError(testutil.FundAccount(ctx, bankKeeper, sender.AccAddr, sdk.NewCoins(sdk.NewCoin(feeDenom, math.NewInt(1e18)))))
+
+			encCfg := nw.GetEncodingConfig()
+			anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
+				Cdc:                    nw.App.AppCodec(),
+				AccountKeeper:          nw.App.GetAccountKeeper(),
+				BankKeeper:             nw.App.GetBankKeeper(),
+				ExtensionOptionChecker: antetypes.HasDynamicFeeExtensionOption,
+				EvmKeeper:              nw.App.GetEVMKeeper(),
+				FeegrantKeeper:         nw.App.GetFeeGrantKeeper(),
+				IBCKeeper:              nw.App.GetIBCKeeper(),
+				FeeMarketKeeper:        nw.App.GetFeeMarketKeeper(),
+				SignModeHandler:        encCfg.TxConfig.SignModeHandler(),
+				SigGasConsumer:         ante.SigVerificationGasConsumer,
+				MaxTxGasWanted:         1_000_000_000,
+				DynamicFeeChecker:      true,
+				FeeDenomOracle:         mockFeeDenomOracle{denom: feeDenom},
+			})
+
+			baseFee := nw.App.GetFeeMarketKeeper().GetBaseFee(ctx).TruncateInt()
+			s.Require().True(baseFee.IsPositive())
+			fee := tc.gasPrice(baseFee).MulRaw(int64(gasLimit)) //#nosec G115 -- gas limit is a constant
+			tx, err := s.factory.BuildCosmosTx(sender.Priv, basefactory.CosmosTxArgs{
+				Gas:  &gasLimit,
+				Fees: sdk.NewCoins(sdk.NewCoin(feeDenom, fee)),
+				Msgs: []sdk.Msg{banktypes.NewMsgSend(
+					sender.AccAddr, s.keyring.GetAccAddr(1), sdk.NewCoins(sdk.NewCoin(constants.ExampleAttoDenom, math.NewInt(1))),
+				)},
+			})
+			s.Require().NoError(err)
+
+			feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+			senderBalance := bankKeeper.GetAllBalances(ctx, sender.AccAddr)
+			collectorBalance := bankKeeper.GetAllBalances(ctx, feeCollector)
+
+			_, err = anteHandler(ctx, tx, false)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			paid := sdk.NewCoins(sdk.NewCoin(feeDenom, fee))
+			s.Require().Equal(senderBalance.Sub(paid...), bankKeeper.GetAllBalances(ctx, sender.AccAddr))
+			s.Require().Equal(collectorBalance.Add(paid...), bankKeeper.GetAllBalances(ctx, feeCollector))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBlockRoots(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// a transfer and a failed contract call emitting no logs in block 1
+	to := common.BigToAddress(big.NewInt(1))
+	txArgs := []types.EvmTxArgs{
+		{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 50000},
+		{Nonce: 1, To: &to, GasLimit: 100000, Input: []byte{0x01}},
+	}
+	txLogs := [][]*ethtypes.Log{
+		{{Address: to, Topics: []common.Hash{common.HexToHash("0xa")}, Data: []byte{0x01}}},
+		nil,
+	}
+	txFailed := []bool{false, true}
+	gasUsed := []uint64{30000, 60000}
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}}
+	var (
+		blockResult []*abci.ExecTxResult
+		expTxs      []*ethtypes.Transaction
+		expReceipts []*ethtypes.Receipt
+		cumulative  uint64
+	)
+	for i, args := range txArgs {
+		tx := types.NewTx(&args)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		block.Txs = append(block.Txs, txBz)
+
+		res := &types.MsgEthereumTxResponse{Hash: txHash.Hex(), Logs: types.NewLogsFromEth(txLogs[i])}
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+		require.NoError(t, err)
+		attrs := []abci.EventAttribute{
+			{Key: "ethereumTxHash", Value: txHash.Hex()},
+			{Key: "txIndex", Value: big.NewInt(int64(i)).String()},
+			{Key: "txGasUsed", Value: new(big.Int).SetUint64(gasUsed[i]).String()},
+		}
+		if txFailed[i] {
+			attrs = append(attrs, abci.EventAttribute{Key: types.AttributeKeyEthereumTxFailed, Value: "execution reverted"})
+		}
+		blockResult = append(blockResult, &abci.ExecTxResult{
+			Code:    0,
+			Data:    data,
+			GasUsed: int64(gasUsed[i]), //nolint:gosec // G115 // gas won't exceed int64
+			Events:  []abci.Event{{Type: types.EventTypeEthereumTx, Attributes: attrs}},
+		})
+
+		// the consensus fields of the receipts, as defined by geth
+		cumulative += gasUsed[i]
+		receipt := &ethtypes.Receipt{
+			Type:              tx.AsTransaction().Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: cumulative,
+			Logs:              txLogs[i],
+		}
+		if txFailed[i] {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+		expTxs = append(expTxs, tx.AsTransaction())
+		expReceipts = append(expReceipts, receipt)
+	}
+
+	expBlock := ethtypes.NewBlock(&ethtypes.Header{}, &ethtypes.Body{Transactions: expTxs}, expReceipts, trie.NewStackTrie(nil))
+	expRoots := &servertypes.BlockRoots{
+		TxRoot:      expBlock.TxHash(),
+		ReceiptRoot: expBlock.ReceiptHash(),
+		Bloom:       expBlock.Bloom(),
+	}
+	require.NotEqual(t, ethtypes.Bloom{}, expRoots.Bloom)
+
+	sqlDB, err := sql.Open(indexer.SQLDriverSQLite, ":memory:")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	sqlDB.SetMaxOpenConns(1)
+	sqlIndexer, err := indexer.NewSQLIndexer(sqlDB, indexer.SQLDriverSQLite, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	for name, idxer := range map[string]servertypes.EVMTxIndexer{
+		"kv":  indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx),
+		"sql": sqlIndexer,
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, idxer.IndexBlock(block, blockResult))
+			// a block without eth txs
+			require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+
+			roots, err := idxer.GetBlockRoots(1)
+			require.NoError(t, err)
+			require.Equal(t, expRoots, roots)
+
+			for _, height := range []int64{2, 3} {
+				roots, err = idxer.GetBlockRoots(height)
+				require.NoError(t, err)
+				require.Nil(t, roots)
+			}
+		})
+	}
+}
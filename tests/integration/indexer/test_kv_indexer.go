@@ -14,6 +14,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
@@ -159,18 +160,23 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			db := dbm.NewMemDB()
-			idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+			idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx, indexer.WithAddressIndex())
 
 			err = idxer.IndexBlock(tc.block, tc.blockResult)
 			require.NoError(t, err)
 			if !tc.expSuccess {
+				// the block is processed without indexing any eth tx
 				first, err := idxer.FirstIndexedBlock()
 				require.NoError(t, err)
-				require.Equal(t, int64(-1), first)
+				require.Equal(t, tc.block.Height, first)
 
 				last, err := idxer.LastIndexedBlock()
 				require.NoError(t, err)
-				require.Equal(t, int64(-1), last)
+				require.Equal(t, tc.block.Height, last)
+
+				res, err := idxer.GetByBlockAndIndex(tc.block.Height, 0)
+				require.Error(t, err)
+				require.Nil(t, res)
 			} else {
 				first, err := idxer.FirstIndexedBlock()
 				require.NoError(t, err)
@@ -186,6 +192,51 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				for _, addr := range []common.Address{from, to} {
+					page, err := idxer.GetByAddress(servertypes.AddressTxQuery{Address: addr, ToBlock: -1})
+					require.NoError(t, err)
+					require.Nil(t, page.Next)
+					require.Len(t, page.Txs, 1)
+					require.Equal(t, txHash, page.Txs[0].Hash)
+					require.Equal(t, int64(1), page.Txs[0].BlockNumber)
+
+					page, err = idxer.GetByAddress(servertypes.AddressTxQuery{Address: addr, FromBlock: 2, ToBlock: -1, Descending: true})
+					require.NoError(t, err)
+					require.Empty(t, page.Txs)
+				}
+
+				page, err := idxer.GetByAddress(servertypes.AddressTxQuery{Address: from, Roles: servertypes.AddressTxRoleTo, ToBlock: -1})
+				require.NoError(t, err)
+				require.Empty(t, page.Txs)
+
+				// paginate over the same tx indexed in two blocks
+				block2 := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: tc.block.Data}
+				require.NoError(t, idxer.IndexBlock(block2, tc.blockResult))
+
+				page, err = idxer.GetByAddress(servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1})
+				require.NoError(t, err)
+				require.Len(t, page.Txs, 1)
+				require.Equal(t, int64(1), page.Txs[0].BlockNumber)
+				require.Equal(t, &servertypes.AddressTxCursor{BlockNumber: 2}, page.Next)
+
+				page, err = idxer.GetByAddress(servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1, Cursor: page.Next})
+				require.NoError(t, err)
+				require.Len(t, page.Txs, 1)
+				require.Equal(t, int64(2), page.Txs[0].BlockNumber)
+				require.Nil(t, page.Next)
+
+				page, err = idxer.GetByAddress(servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1, Descending: true})
+				require.NoError(t, err)
+				require.Len(t, page.Txs, 1)
+				require.Equal(t, int64(2), page.Txs[0].BlockNumber)
+				require.Equal(t, &servertypes.AddressTxCursor{BlockNumber: 1}, page.Next)
+
+				page, err = idxer.GetByAddress(servertypes.AddressTxQuery{Address: from, ToBlock: 1, Limit: 1, Descending: true})
+				require.NoError(t, err)
+				require.Len(t, page.Txs, 1)
+				require.Equal(t, int64(1), page.Txs[0].BlockNumber)
+				require.Nil(t, page.Next)
 			}
 		})
 	}
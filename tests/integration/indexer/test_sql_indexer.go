@@ -0,0 +1,184 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db, err := sql.Open(indexer.SQLDriverSQLite, ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	idxer, err := indexer.NewSQLIndexer(db, indexer.SQLDriverSQLite, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+
+	// a transfer in block 1 and a contract creation in block 2
+	to := common.BigToAddress(big.NewInt(1))
+	contract := crypto.CreateAddress(from, 1)
+	txArgs := []types.EvmTxArgs{
+		{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 21000},
+		{Nonce: 1, GasLimit: 100000, Input: []byte{0x60, 0x00}},
+	}
+	var txHashes []common.Hash
+	for i, args := range txArgs {
+		tx := types.NewTx(&args)
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		txHashes = append(txHashes, txHash)
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: int64(i + 1)}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code:    0,
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			},
+		}
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+		// indexing a block again replaces its rows
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+	}
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last)
+
+	for i, txHash := range txHashes {
+		res1, err := idxer.GetByTxHash(txHash)
+		require.NoError(t, err)
+		require.Equal(t, &servertypes.TxResult{
+			Height:            int64(i + 1),
+			GasUsed:           21000,
+			CumulativeGasUsed: 21000,
+		}, res1)
+		res2, err := idxer.GetByBlockAndIndex(int64(i+1), 0)
+		require.NoError(t, err)
+		require.Equal(t, res1, res2)
+	}
+	_, err = idxer.GetByTxHash(common.Hash{})
+	require.ErrorContains(t, err, "tx not found")
+	_, err = idxer.GetByBlockAndIndex(1, 1)
+	require.ErrorContains(t, err, "tx not found")
+
+	testCases := []struct {
+		name    string
+		query   servertypes.AddressTxQuery
+		expTxs  []servertypes.AddressTx
+		expNext *servertypes.AddressTxCursor
+	}{
+		{
+			"sender",
+			servertypes.AddressTxQuery{Address: from, ToBlock: -1},
+			[]servertypes.AddressTx{
+				{Hash: txHashes[0], BlockNumber: 1, Roles: servertypes.AddressTxRoleFrom},
+				{Hash: txHashes[1], BlockNumber: 2, Roles: servertypes.AddressTxRoleFrom},
+			},
+			nil,
+		},
+		{
+			"recipient",
+			servertypes.AddressTxQuery{Address: to, ToBlock: -1},
+			[]servertypes.AddressTx{{Hash: txHashes[0], BlockNumber: 1, Roles: servertypes.AddressTxRoleTo}},
+			nil,
+		},
+		{
+			"created contract",
+			servertypes.AddressTxQuery{Address: contract, ToBlock: -1},
+			[]servertypes.AddressTx{{Hash: txHashes[1], BlockNumber: 2, Roles: servertypes.AddressTxRoleCreated}},
+			nil,
+		},
+		{
+			"roles filter",
+			servertypes.AddressTxQuery{Address: from, Roles: servertypes.AddressTxRoleTo, ToBlock: -1},
+			nil,
+			nil,
+		},
+		{
+			"block range",
+			servertypes.AddressTxQuery{Address: from, FromBlock: 2, ToBlock: 2},
+			[]servertypes.AddressTx{{Hash: txHashes[1], BlockNumber: 2, Roles: servertypes.AddressTxRoleFrom}},
+			nil,
+		},
+		{
+			"first page",
+			servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1},
+			[]servertypes.AddressTx{{Hash: txHashes[0], BlockNumber: 1, Roles: servertypes.AddressTxRoleFrom}},
+			&servertypes.AddressTxCursor{BlockNumber: 2},
+		},
+		{
+			"last page",
+			servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1, Cursor: &servertypes.AddressTxCursor{BlockNumber: 2}},
+			[]servertypes.AddressTx{{Hash: txHashes[1], BlockNumber: 2, Roles: servertypes.AddressTxRoleFrom}},
+			nil,
+		},
+		{
+			"descending first page",
+			servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1, Descending: true},
+			[]servertypes.AddressTx{{Hash: txHashes[1], BlockNumber: 2, Roles: servertypes.AddressTxRoleFrom}},
+			&servertypes.AddressTxCursor{BlockNumber: 1},
+		},
+		{
+			"descending last page",
+			servertypes.AddressTxQuery{Address: from, ToBlock: -1, Limit: 1, Descending: true, Cursor: &servertypes.AddressTxCursor{BlockNumber: 1}},
+			[]servertypes.AddressTx{{Hash: txHashes[0], BlockNumber: 1, Roles: servertypes.AddressTxRoleFrom}},
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := idxer.GetByAddress(tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, page.Txs)
+			require.Equal(t, tc.expNext, page.Next)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -16,7 +17,10 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -47,6 +51,39 @@ func (s *IntegrationTestSuite) createCosmosSendTx(key keyring.Key, gasPrice *big
 	return tx
 }
 
+// createCosmosSendTxAt creates a bank send transaction with the specified key at
+// the sequence, with a fixed gas limit as it may not be simulated at its sequence
+func (s *IntegrationTestSuite) createCosmosSendTxAt(key keyring.Key, gasPrice *big.Int, sequence uint64) sdk.Tx {
+	txConfig := s.network.App.GetTxConfig()
+	account := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), key.AccAddr)
+	s.Require().NotNil(account)
+
+	txBuilder := txConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(key.AccAddr, s.keyring.GetKey(1).AccAddr, sdk.NewCoins(sdk.NewInt64Coin("aatom", 1000)))))
+	txBuilder.SetGasLimit(TxGas)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("aatom", sdkmath.NewIntFromBigInt(gasPrice).MulRaw(TxGas))))
+
+	signMode, err := authsigning.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   key.Priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: sequence,
+	}))
+	signerData := authsigning.SignerData{
+		ChainID:       s.network.GetChainID(),
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      sequence,
+		Address:       key.AccAddr.String(),
+		PubKey:        key.Priv.PubKey(),
+	}
+	signature, err := cosmostx.SignWithPrivKey(context.TODO(), signMode, signerData, txBuilder, key.Priv, txConfig, sequence)
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(signature))
+
+	return txBuilder.GetTx()
+}
+
 // createEVMTransaction creates an EVM transaction using the provided key
 func (s *IntegrationTestSuite) createEVMValueTransferTx(key keyring.Key, nonce int, gasPrice *big.Int) sdk.Tx {
 	to := s.keyring.GetKey(1).Addr
@@ -142,6 +179,20 @@ func (s *IntegrationTestSuite) checkTx(tx sdk.Tx) (*abci.ResponseCheckTx, error)
 	return res, nil
 }
 
+// recheckTx calls abci CheckTx for a transaction in recheck mode, as CometBFT
+// does for the transactions of its mempool after a commit
+func (s *IntegrationTestSuite) recheckTx(tx sdk.Tx) *abci.ResponseCheckTx {
+	txBytes, err := s.getTxBytes([]sdk.Tx{tx})
+	s.Require().NoError(err)
+
+	res, err := s.network.App.CheckTx(&abci.RequestCheckTx{
+		Tx:   txBytes[0],
+		Type: abci.CheckTxType_Recheck,
+	})
+	s.Require().NoError(err)
+	return res
+}
+
 func (s *IntegrationTestSuite) getTxBytes(txs []sdk.Tx) ([][]byte, error) {
 	txEncoder := s.network.App.GetTxConfig().TxEncoder()
 	txBytes := make([][]byte, 0)
@@ -0,0 +1,57 @@
+package mempool
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil/integration/base/factory"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestFeeGrantedEVMTxCheckTx tests that an EVM transaction paid by a fee granter,
+// which is kept in the Cosmos pool, is inserted under its sender and nonce
+func (s *IntegrationTestSuite) TestFeeGrantedEVMTxCheckTx() {
+	s.SetupTest()
+
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+
+	sender := s.keyring.GetKey(0)
+	// the granter sponsors the calls made to it, the value transfers are sent to key 1
+	granter := s.keyring.GetKey(1)
+
+	msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, granter.AccAddr, sender.AccAddr)
+	s.Require().NoError(err)
+	_, err = s.factory.CommitCosmosTx(granter.Priv, factory.CosmosTxArgs{Msgs: []sdk.Msg{msg}})
+	s.Require().NoError(err)
+	// wait for the mempool reset triggered by the new block
+	time.Sleep(100 * time.Millisecond)
+
+	tx := s.createEVMValueTransferTx(sender, 0, big.NewInt(1000000000))
+	builder, err := s.network.App.GetTxConfig().WrapTxBuilder(tx)
+	s.Require().NoError(err)
+	builder.SetFeeGranter(granter.AccAddr)
+	tx = builder.GetTx()
+
+	res, err := s.checkTx(tx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+	s.Require().Equal(1, mpool.CountTx())
+
+	content, err := mpool.CosmosContentFrom(sender.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(uint64(0), content[0].Sequence)
+	s.Require().Equal(s.getTxHash(tx), hex.EncodeToString(content[0].Hash))
+
+	// the removal finds the transaction under the same signer and sequence
+	s.Require().NoError(mpool.Remove(tx))
+	s.Require().Equal(0, mpool.CountTx())
+}
@@ -0,0 +1,106 @@
+package mempool
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// TestMempoolInspection tests the inspection of the Cosmos transactions and of
+// the predicted selection of the next block
+func (s *IntegrationTestSuite) TestMempoolInspection() {
+	s.SetupTest()
+
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+
+	// the EVM transaction pays the highest tip and is selected first
+	lowFeeKey, highFeeKey, evmKey := s.keyring.GetKey(0), s.keyring.GetKey(1), s.keyring.GetKey(2)
+	txs := []sdk.Tx{
+		s.createCosmosSendTx(lowFeeKey, big.NewInt(1000000000)),
+		s.createCosmosSendTx(highFeeKey, big.NewInt(2000000000)),
+		s.createEVMValueTransferTx(evmKey, 0, big.NewInt(3000000000)),
+	}
+	for _, tx := range txs {
+		s.Require().NoError(mpool.Insert(s.network.GetContext(), tx))
+	}
+
+	content, err := mpool.CosmosContent()
+	s.Require().NoError(err)
+	s.Require().Len(content, 2)
+	s.Require().Equal(highFeeKey.AccAddr, content[0].Signer)
+	s.Require().Equal(lowFeeKey.AccAddr, content[1].Signer)
+	s.Require().True(content[0].Priority.GT(content[1].Priority))
+	s.Require().Equal([]string{sdk.MsgTypeURL(txs[1].GetMsgs()[0])}, content[0].MsgTypeURLs)
+
+	content, err = mpool.CosmosContentFrom(lowFeeKey.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(s.getTxHash(txs[0]), hex.EncodeToString(content[0].Hash))
+	s.Require().Equal(txs[0].(sdk.FeeTx).GetGas(), content[0].GasWanted)
+
+	block, err := mpool.NextBlock()
+	s.Require().NoError(err)
+	s.Require().Len(block.Txs, 3)
+	s.Require().NotNil(block.Txs[0].EVMTx)
+	s.Require().Equal(evmKey.Addr, block.Txs[0].From)
+	s.Require().Equal(highFeeKey.AccAddr, block.Txs[1].CosmosTx.Signer)
+	s.Require().Equal(lowFeeKey.AccAddr, block.Txs[2].CosmosTx.Signer)
+	s.Require().Equal(block.Txs[0].Gas+block.Txs[1].Gas+block.Txs[2].Gas, block.Gas)
+
+	// the gRPC service reports the same content
+	queryServer := evmmempool.NewQueryServer(mpool)
+	status, err := queryServer.Status(s.network.GetContext(), &types.QueryStatusRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryStatusResponse{EvmPending: 1, Cosmos: 2}, status)
+
+	res, err := queryServer.CosmosContentFrom(s.network.GetContext(), &types.QueryCosmosContentFromRequest{Address: highFeeKey.AccAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Txs, 1)
+	s.Require().Equal(strings.ToUpper(s.getTxHash(txs[1])), res.Txs[0].Hash)
+
+	_, err = queryServer.CosmosContentFrom(s.network.GetContext(), &types.QueryCosmosContentFromRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	// the content is paginated in the order of the Cosmos pool
+	page, err := queryServer.CosmosContent(s.network.GetContext(), &types.QueryCosmosContentRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(page.Txs, 1)
+	s.Require().Equal(highFeeKey.AccAddr.String(), page.Txs[0].Signer)
+	s.Require().Equal(uint64(2), page.Pagination.Total)
+	s.Require().NotEmpty(page.Pagination.NextKey)
+
+	page, err = queryServer.CosmosContent(s.network.GetContext(), &types.QueryCosmosContentRequest{
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(page.Txs, 1)
+	s.Require().Equal(lowFeeKey.AccAddr.String(), page.Txs[0].Signer)
+	s.Require().Empty(page.Pagination.NextKey)
+
+	page, err = queryServer.CosmosContent(s.network.GetContext(), &types.QueryCosmosContentRequest{
+		Pagination: &query.PageRequest{Offset: 1, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(page.Txs, 1)
+	s.Require().Equal(highFeeKey.AccAddr.String(), page.Txs[0].Signer)
+
+	_, err = queryServer.CosmosContent(s.network.GetContext(), &types.QueryCosmosContentRequest{
+		Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+	})
+	s.Require().Error(err)
+
+	nextBlock, err := queryServer.NextBlock(s.network.GetContext(), &types.QueryNextBlockRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(nextBlock.Txs, 3)
+	s.Require().True(nextBlock.Txs[0].Evm)
+	s.Require().Equal(evmKey.Addr.Hex(), nextBlock.Txs[0].Sender)
+}
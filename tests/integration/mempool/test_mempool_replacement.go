@@ -0,0 +1,128 @@
+package mempool
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestCosmosTxReplacement tests the fee-bump replacement and the cancellation of
+// the Cosmos transactions through CheckTx
+func (s *IntegrationTestSuite) TestCosmosTxReplacement() {
+	s.SetupTest()
+
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+
+	key := s.keyring.GetKey(0)
+	tx := s.createCosmosSendTx(key, big.NewInt(1000000000))
+	res, err := s.checkTx(tx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+	content, err := mpool.CosmosContentFrom(key.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	sequence := content[0].Sequence
+
+	// the same sequence with a priority bumped by less than the price bump is rejected
+	underpricedTx := s.createCosmosSendTxAt(key, big.NewInt(1050000000), sequence)
+	res, err = s.checkTx(underpricedTx)
+	s.Require().NoError(err)
+	s.Require().NotEqual(abci.CodeTypeOK, res.Code)
+	s.Require().Contains(res.Log, evmmempool.ErrReplaceUnderpriced.Error())
+
+	// a priority bumped by the price bump replaces the transaction
+	replacementTx := s.createCosmosSendTxAt(key, big.NewInt(1100000000), sequence)
+	res, err = s.checkTx(replacementTx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+	content, err = mpool.CosmosContentFrom(key.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(sequence, content[0].Sequence)
+	s.Require().Equal(s.getTxHash(replacementTx), hex.EncodeToString(content[0].Hash))
+
+	// the cancellation removes the transaction holding the sequence
+	cancelled, err := mpool.CancelCosmosTx(key.AccAddr, sequence)
+	s.Require().NoError(err)
+	s.Require().Len(cancelled, 1)
+	s.Require().Equal(s.getTxHash(replacementTx), hex.EncodeToString(cancelled[0].Hash))
+	s.Require().Equal(0, mpool.CountCosmosTx())
+
+	_, err = mpool.CancelCosmosTx(key.AccAddr, sequence)
+	s.Require().ErrorIs(err, evmmempool.ErrCosmosTxNotFound)
+
+	// the cancelled transaction cannot take the sequence again, a new one can
+	txBytes, err := s.getTxBytes([]sdk.Tx{replacementTx})
+	s.Require().NoError(err)
+	s.Require().ErrorIs(mpool.ReplaceCosmosTx(txBytes[0]), evmmempool.ErrNotReplaceable)
+
+	newTx := s.createCosmosSendTxAt(key, big.NewInt(1200000000), sequence)
+	res, err = s.checkTx(newTx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+	content, err = mpool.CosmosContentFrom(key.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(s.getTxHash(newTx), hex.EncodeToString(content[0].Hash))
+}
+
+// TestCosmosTxReplacementRecheck tests that the recheck of a replaced Cosmos
+// transaction, which the Cosmos pool removes by signer and sequence, doesn't
+// remove the replacement
+func (s *IntegrationTestSuite) TestCosmosTxReplacementRecheck() {
+	s.SetupTest()
+
+	mpool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+
+	key := s.keyring.GetKey(0)
+	sequence := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), key.AccAddr).GetSequence()
+	tx := s.createCosmosSendTxAt(key, big.NewInt(1000000000), sequence)
+	replacementTx := s.createCosmosSendTxAt(key, big.NewInt(1100000000), sequence)
+	for _, tx := range []sdk.Tx{tx, replacementTx} {
+		res, err := s.checkTx(tx)
+		s.Require().NoError(err)
+		s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+	}
+
+	// both transactions are rechecked in the order of the CometBFT mempool: the
+	// replaced transaction takes the sequence again in the check state and the
+	// replacement failing its recheck takes it back in the Cosmos pool
+	s.Require().NoError(s.network.NextBlock())
+	s.notifyNewBlockToMempool()
+
+	res := s.recheckTx(tx)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+	res = s.recheckTx(replacementTx)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+	content, err := mpool.CosmosContentFrom(key.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(s.getTxHash(replacementTx), hex.EncodeToString(content[0].Hash))
+
+	// the replaced transaction failing its recheck doesn't remove the replacement
+	// holding its signer and sequence
+	s.Require().NoError(s.network.NextBlock())
+	s.notifyNewBlockToMempool()
+
+	res = s.recheckTx(replacementTx)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+	res = s.recheckTx(tx)
+	s.Require().NotEqual(abci.CodeTypeOK, res.Code)
+	s.Require().Contains(res.Log, evmmempool.ErrReplaceUnderpriced.Error())
+
+	content, err = mpool.CosmosContentFrom(key.AccAddr)
+	s.Require().NoError(err)
+	s.Require().Len(content, 1)
+	s.Require().Equal(s.getTxHash(replacementTx), hex.EncodeToString(content[0].Hash))
+}
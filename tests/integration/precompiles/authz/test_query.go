@@ -0,0 +1,125 @@
+package authz
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	genericAuthorizationType = sdk.MsgTypeURL(&authztypes.GenericAuthorization{})
+	sendAuthorizationType    = sdk.MsgTypeURL(&banktypes.SendAuthorization{})
+)
+
+func (s *PrecompileTestSuite) TestGrantsQueries() {
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+	other := s.keyring.GetKey(2)
+	denom := s.network.GetBaseDenom()
+	expiration := s.network.GetContext().BlockTime().Add(time.Hour).Unix()
+
+	// granter grants a vote authorization to the grantee and a send
+	// authorization to the other account
+	grantMethod := s.precompile.Methods[authz.GrantMethod]
+	grantSendMethod := s.precompile.Methods[authz.GrantSendMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	_, err := s.precompile.Grant(ctx, &grantMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, msgVoteTypeURL, expiration,
+	})
+	s.Require().NoError(err)
+	_, err = s.precompile.GrantSend(ctx, &grantSendMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, other.Addr, []cmn.Coin{{Denom: denom, Amount: big.NewInt(10)}}, []common.Address{grantee.Addr}, int64(0),
+	})
+	s.Require().NoError(err)
+
+	voteGrant := authz.Grant{
+		Granter:           granter.Addr,
+		Grantee:           grantee.Addr,
+		AuthorizationType: genericAuthorizationType,
+		MsgTypeUrl:        msgVoteTypeURL,
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+		Expiration:        expiration,
+	}
+	sendGrant := authz.Grant{
+		Granter:           granter.Addr,
+		Grantee:           other.Addr,
+		AuthorizationType: sendAuthorizationType,
+		MsgTypeUrl:        msgSendTypeURL,
+		SpendLimit:        []cmn.Coin{{Denom: denom, Amount: big.NewInt(10)}},
+		AllowList:         []common.Address{grantee.Addr},
+	}
+
+	testCases := []struct {
+		name      string
+		method    string
+		args      []interface{}
+		expGrants []authz.Grant
+	}{
+		{
+			"grants",
+			authz.GrantsMethod,
+			[]interface{}{granter.Addr, grantee.Addr, "", query.PageRequest{}},
+			[]authz.Grant{voteGrant},
+		},
+		{
+			"grants by msg type",
+			authz.GrantsMethod,
+			[]interface{}{granter.Addr, other.Addr, msgSendTypeURL, query.PageRequest{}},
+			[]authz.Grant{sendGrant},
+		},
+		{
+			"granter grants",
+			authz.GranterGrantsMethod,
+			[]interface{}{granter.Addr, query.PageRequest{}},
+			[]authz.Grant{voteGrant, sendGrant},
+		},
+		{
+			"grantee grants",
+			authz.GranteeGrantsMethod,
+			[]interface{}{other.Addr, query.PageRequest{}},
+			[]authz.Grant{sendGrant},
+		},
+		{
+			"no grants",
+			authz.GranteeGrantsMethod,
+			[]interface{}{granter.Addr, query.PageRequest{}},
+			[]authz.Grant{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.method {
+			case authz.GrantsMethod:
+				bz, err = s.precompile.Grants(ctx, &method, contract, tc.args)
+			case authz.GranterGrantsMethod:
+				bz, err = s.precompile.GranterGrants(ctx, &method, contract, tc.args)
+			case authz.GranteeGrantsMethod:
+				bz, err = s.precompile.GranteeGrants(ctx, &method, contract, tc.args)
+			}
+			s.Require().NoError(err)
+
+			values, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			var out authz.GrantsOutput
+			s.Require().NoError(method.Outputs.Copy(&out, values))
+			s.Require().ElementsMatch(tc.expGrants, out.Grants)
+		})
+	}
+}
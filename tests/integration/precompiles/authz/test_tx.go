@@ -0,0 +1,243 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var (
+	msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgVoteTypeURL = sdk.MsgTypeURL(&govv1.MsgVote{})
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+
+	testCases := []struct {
+		name        string
+		caller      common.Address
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			granter.Addr,
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - caller is not the granter",
+			grantee.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, msgVoteTypeURL, int64(0)},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - negative expiration",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, msgVoteTypeURL, int64(-1)},
+			true,
+			fmt.Sprintf(authz.ErrInvalidExpiration, -1),
+		},
+		{
+			"fail - disallowed msg type",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)},
+			true,
+			fmt.Sprintf(authz.ErrDisallowedMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			"fail - msg type routing back into the EVM",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}), int64(0)},
+			true,
+			fmt.Sprintf(authz.ErrDisallowedMsgType, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{})),
+		},
+		{
+			"fail - grantee is granter",
+			granter.Addr,
+			[]interface{}{granter.Addr, granter.Addr, msgVoteTypeURL, int64(0)},
+			true,
+			authztypes.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"success - generic authorization",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, msgVoteTypeURL, int64(0)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, s.precompile.Address(), 200_000)
+			res, err := s.precompile.Grant(ctx, &method, stateDB, contract, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, msgVoteTypeURL)
+			s.Require().NotNil(authorization)
+			s.Require().Nil(expiration)
+
+			// the AuthorizationGranted event is emitted
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[authz.EventTypeAuthorizationGranted].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSendAndExec() {
+	grantMethod := s.precompile.Methods[authz.GrantSendMethod]
+	execMethod := s.precompile.Methods[authz.ExecMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+	receiver := utiltx.GenerateAddress()
+	denom := s.network.GetBaseDenom()
+
+	spendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1000)}}
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	res, err := s.precompile.GrantSend(ctx, &grantMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, spendLimit, []common.Address{receiver}, int64(0),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	newMsgSend := func(to common.Address, amount int64) []byte {
+		msg := banktypes.NewMsgSend(granter.AccAddr, to.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(amount))))
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		return bz
+	}
+
+	// the caller must be the grantee
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter.Addr, s.precompile.Address(), 200_000)
+	_, err = s.precompile.Exec(ctx, &execMethod, s.network.GetStateDB(), contract, []interface{}{
+		grantee.Addr, [][]byte{newMsgSend(receiver, 100)},
+	})
+	s.Require().ErrorContains(err, "does not match the requester address")
+
+	// the receiver must be in the allow list
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, grantee.Addr, s.precompile.Address(), 200_000)
+	_, err = s.precompile.Exec(ctx, &execMethod, s.network.GetStateDB(), contract, []interface{}{
+		grantee.Addr, [][]byte{newMsgSend(utiltx.GenerateAddress(), 100)},
+	})
+	s.Require().ErrorContains(err, "cannot send to")
+
+	// nested authz msgs are rejected
+	nested, err := s.network.App.AppCodec().MarshalInterfaceJSON(&authztypes.MsgExec{Grantee: grantee.AccAddr.String()})
+	s.Require().NoError(err)
+	_, err = s.precompile.Exec(ctx, &execMethod, s.network.GetStateDB(), contract, []interface{}{
+		grantee.Addr, [][]byte{nested},
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf(authz.ErrNestedAuthzMsg, sdk.MsgTypeURL(&authztypes.MsgExec{})))
+
+	// msgs routing back into the EVM are rejected, even when signed by the grantee
+	convert, err := s.network.App.AppCodec().MarshalInterfaceJSON(&erc20types.MsgConvertERC20{
+		ContractAddress: utiltx.GenerateAddress().Hex(),
+		Amount:          math.NewInt(100),
+		Receiver:        grantee.AccAddr.String(),
+		Sender:          grantee.Addr.Hex(),
+	})
+	s.Require().NoError(err)
+	_, err = s.precompile.Exec(ctx, &execMethod, s.network.GetStateDB(), contract, []interface{}{
+		grantee.Addr, [][]byte{convert},
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf(authz.ErrDisallowedMsgType, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{})))
+
+	stateDB := s.network.GetStateDB()
+	res, err = s.precompile.Exec(ctx, &execMethod, stateDB, contract, []interface{}{
+		grantee.Addr, [][]byte{newMsgSend(receiver, 100)},
+	})
+	s.Require().NoError(err)
+	results, err := execMethod.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Len(results[0], 1)
+
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), denom)
+	s.Require().Equal(math.NewInt(100), balance.Amount)
+
+	// the spend limit is decreased by the sent amount
+	authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, msgSendTypeURL)
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(900))), sendAuthz.SpendLimit)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 1)
+	s.Require().Equal(s.precompile.Events[authz.EventTypeAuthorizationExecuted].ID, logs[0].Topics[0])
+
+	// the spend limit cannot be exceeded
+	_, err = s.precompile.Exec(ctx, &execMethod, s.network.GetStateDB(), contract, []interface{}{
+		grantee.Addr, [][]byte{newMsgSend(receiver, 1000)},
+	})
+	s.Require().ErrorContains(err, "insufficient funds")
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	grantMethod := s.precompile.Methods[authz.GrantMethod]
+	revokeMethod := s.precompile.Methods[authz.RevokeMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	_, err := s.precompile.Revoke(ctx, &revokeMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, msgVoteTypeURL,
+	})
+	s.Require().ErrorContains(err, "authorization not found")
+
+	_, err = s.precompile.Grant(ctx, &grantMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, msgVoteTypeURL, int64(0),
+	})
+	s.Require().NoError(err)
+
+	// only the granter can revoke the grant
+	granteeContract, ctx := testutil.NewPrecompileContract(s.T(), ctx, grantee.Addr, s.precompile.Address(), 200_000)
+	_, err = s.precompile.Revoke(ctx, &revokeMethod, s.network.GetStateDB(), granteeContract, []interface{}{
+		granter.Addr, grantee.Addr, msgVoteTypeURL,
+	})
+	s.Require().ErrorContains(err, "does not match the requester address")
+
+	stateDB := s.network.GetStateDB()
+	res, err := s.precompile.Revoke(ctx, &revokeMethod, stateDB, contract, []interface{}{
+		granter.Addr, grantee.Addr, msgVoteTypeURL,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, grantee.AccAddr, granter.AccAddr, msgVoteTypeURL)
+	s.Require().Nil(authorization)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 1)
+	s.Require().Equal(s.precompile.Events[authz.EventTypeAuthorizationRevoked].ID, logs[0].Topics[0])
+}
@@ -0,0 +1,128 @@
+package feegrant
+
+import (
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	basicAllowanceType    = sdk.MsgTypeURL(&feegranttypes.BasicAllowance{})
+	periodicAllowanceType = sdk.MsgTypeURL(&feegranttypes.PeriodicAllowance{})
+)
+
+func (s *PrecompileTestSuite) TestAllowanceQueries() {
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+	other := s.keyring.GetKey(2)
+	denom := s.network.GetBaseDenom()
+	expiration := s.network.GetContext().BlockTime().Add(time.Hour).Unix()
+
+	// granter grants a basic allowance to the grantee and a periodic
+	// allowance to the other account
+	grantMethod := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	grantPeriodicMethod := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	_, err := s.precompile.GrantAllowance(ctx, &grantMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, []cmn.Coin{{Denom: denom, Amount: big.NewInt(1000)}}, expiration,
+	})
+	s.Require().NoError(err)
+	_, err = s.precompile.GrantPeriodicAllowance(ctx, &grantPeriodicMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, other.Addr, []cmn.Coin{}, int64(0), int64(60), []cmn.Coin{{Denom: denom, Amount: big.NewInt(10)}},
+	})
+	s.Require().NoError(err)
+
+	basicAllowance := feegrant.Allowance{
+		Granter:          granter.Addr,
+		Grantee:          grantee.Addr,
+		AllowanceType:    basicAllowanceType,
+		SpendLimit:       []cmn.Coin{{Denom: denom, Amount: big.NewInt(1000)}},
+		Expiration:       expiration,
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+	periodicAllowance := feegrant.Allowance{
+		Granter:          granter.Addr,
+		Grantee:          other.Addr,
+		AllowanceType:    periodicAllowanceType,
+		SpendLimit:       []cmn.Coin{},
+		Period:           60,
+		PeriodSpendLimit: []cmn.Coin{{Denom: denom, Amount: big.NewInt(10)}},
+		PeriodCanSpend:   []cmn.Coin{{Denom: denom, Amount: big.NewInt(10)}},
+		PeriodReset:      ctx.BlockTime().Add(time.Minute).Unix(),
+		AllowedMessages:  []string{},
+	}
+
+	s.Run("allowance", func() {
+		method := s.precompile.Methods[feegrant.AllowanceMethod]
+		bz, err := s.precompile.Allowance(ctx, &method, contract, []interface{}{granter.Addr, other.Addr})
+		s.Require().NoError(err)
+
+		values, err := method.Outputs.Unpack(bz)
+		s.Require().NoError(err)
+		var out struct{ Allowance feegrant.Allowance }
+		s.Require().NoError(method.Outputs.Copy(&out, values))
+		s.Require().Equal(periodicAllowance, out.Allowance)
+
+		_, err = s.precompile.Allowance(ctx, &method, contract, []interface{}{grantee.Addr, other.Addr})
+		s.Require().ErrorContains(err, "not found")
+	})
+
+	testCases := []struct {
+		name          string
+		method        string
+		args          []interface{}
+		expAllowances []feegrant.Allowance
+	}{
+		{
+			"allowances",
+			feegrant.AllowancesMethod,
+			[]interface{}{grantee.Addr, query.PageRequest{}},
+			[]feegrant.Allowance{basicAllowance},
+		},
+		{
+			"allowances by granter",
+			feegrant.AllowancesByGranterMethod,
+			[]interface{}{granter.Addr, query.PageRequest{}},
+			[]feegrant.Allowance{basicAllowance, periodicAllowance},
+		},
+		{
+			"no allowances",
+			feegrant.AllowancesMethod,
+			[]interface{}{granter.Addr, query.PageRequest{}},
+			[]feegrant.Allowance{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.method {
+			case feegrant.AllowancesMethod:
+				bz, err = s.precompile.Allowances(ctx, &method, contract, tc.args)
+			case feegrant.AllowancesByGranterMethod:
+				bz, err = s.precompile.AllowancesByGranter(ctx, &method, contract, tc.args)
+			}
+			s.Require().NoError(err)
+
+			values, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			var out feegrant.AllowancesOutput
+			s.Require().NoError(method.Outputs.Copy(&out, values))
+			s.Require().ElementsMatch(tc.expAllowances, out.Allowances)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+	denom := s.network.GetBaseDenom()
+	spendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(1000)}}
+
+	testCases := []struct {
+		name        string
+		caller      common.Address
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			granter.Addr,
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - caller is not the granter",
+			grantee.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, spendLimit, int64(0)},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - negative expiration",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, spendLimit, int64(-1)},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidExpiration, -1),
+		},
+		{
+			"fail - grantee is granter",
+			granter.Addr,
+			[]interface{}{granter.Addr, granter.Addr, spendLimit, int64(0)},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"success - basic allowance",
+			granter.Addr,
+			[]interface{}{granter.Addr, grantee.Addr, spendLimit, int64(0)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, s.precompile.Address(), 200_000)
+			res, err := s.precompile.GrantAllowance(ctx, &method, stateDB, contract, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(cmn.TrueValue, res)
+
+			allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+			s.Require().NoError(err)
+			basic, ok := allowance.(*feegranttypes.BasicAllowance)
+			s.Require().True(ok)
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))), basic.SpendLimit)
+			s.Require().Nil(basic.Expiration)
+
+			// the AllowanceGranted event is emitted
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			s.Require().Equal(s.precompile.Events[feegrant.EventTypeAllowanceGranted].ID, logs[0].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+	denom := s.network.GetBaseDenom()
+	periodSpendLimit := []cmn.Coin{{Denom: denom, Amount: big.NewInt(100)}}
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	_, err := s.precompile.GrantPeriodicAllowance(ctx, &method, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), int64(0), periodSpendLimit,
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf(feegrant.ErrInvalidPeriod, 0))
+
+	res, err := s.precompile.GrantPeriodicAllowance(ctx, &method, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), int64(3600), periodSpendLimit,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+	s.Require().NoError(err)
+	periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(time.Hour, periodic.Period)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))), periodic.PeriodCanSpend)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+
+	// an allowance cannot be granted twice
+	_, err = s.precompile.GrantPeriodicAllowance(ctx, &method, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0), int64(3600), periodSpendLimit,
+	})
+	s.Require().ErrorContains(err, "fee allowance already exists")
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	grantMethod := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	revokeMethod := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	granter := s.keyring.GetKey(0)
+	grantee := s.keyring.GetKey(1)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter.Addr, s.precompile.Address(), 200_000)
+	_, err := s.precompile.RevokeAllowance(ctx, &revokeMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr,
+	})
+	s.Require().ErrorContains(err, "not found")
+
+	_, err = s.precompile.GrantAllowance(ctx, &grantMethod, s.network.GetStateDB(), contract, []interface{}{
+		granter.Addr, grantee.Addr, []cmn.Coin{}, int64(0),
+	})
+	s.Require().NoError(err)
+
+	// only the granter can revoke the allowance
+	granteeContract, ctx := testutil.NewPrecompileContract(s.T(), ctx, grantee.Addr, s.precompile.Address(), 200_000)
+	_, err = s.precompile.RevokeAllowance(ctx, &revokeMethod, s.network.GetStateDB(), granteeContract, []interface{}{
+		granter.Addr, grantee.Addr,
+	})
+	s.Require().ErrorContains(err, "does not match the requester address")
+
+	stateDB := s.network.GetStateDB()
+	res, err := s.precompile.RevokeAllowance(ctx, &revokeMethod, stateDB, contract, []interface{}{
+		granter.Addr, grantee.Addr,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, grantee.AccAddr)
+	s.Require().Error(err)
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 1)
+	s.Require().Equal(s.precompile.Events[feegrant.EventTypeAllowanceRevoked].ID, logs[0].Topics[0])
+}
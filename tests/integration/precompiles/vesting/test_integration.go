@@ -0,0 +1,254 @@
+package vesting
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling vesting precompile from EOA", func() {
+		var (
+			s *PrecompileTestSuite
+
+			funderAddr common.Address
+			// vestingAddr is the address of the vesting account to create, it must not exist yet
+			vestingAddr common.Address
+			amount      []cmn.Coin
+		)
+
+		// vestingAccount returns the vesting account created by the precompile in the auth state.
+		vestingAccount := func() vestingexported.VestingAccount {
+			acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+			Expect(acc).ToNot(BeNil(), "vesting account should exist")
+			vestingAcc, ok := acc.(vestingexported.VestingAccount)
+			Expect(ok).To(BeTrue(), "account should be a vesting account, got %T", acc)
+			return vestingAcc
+		}
+
+		// querySchedule returns the vesting schedule served by the precompile.
+		querySchedule := func() vesting.VestingSchedule {
+			res, err := s.factory.QueryContract(txArgs, testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+				MethodName:  vesting.GetVestingScheduleMethod,
+				Args:        []interface{}{vestingAddr},
+			}, 0)
+			Expect(err).To(BeNil())
+
+			var out vesting.VestingScheduleOutput
+			Expect(s.precompile.UnpackIntoInterface(&out, vesting.GetVestingScheduleMethod, res.Ret)).To(BeNil())
+			return out.Schedule
+		}
+
+		// queryBalances returns the vesting balances served by the precompile.
+		queryBalances := func() vesting.BalancesOutput {
+			res, err := s.factory.QueryContract(txArgs, testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+				MethodName:  vesting.BalancesMethod,
+				Args:        []interface{}{vestingAddr},
+			}, 0)
+			Expect(err).To(BeNil())
+
+			var out vesting.BalancesOutput
+			Expect(s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, res.Ret)).To(BeNil())
+			return out
+		}
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To:       &precompileAddr,
+				GasLimit: 500_000,
+			}
+
+			funderAddr = s.keyring.GetAddr(0)
+			vestingAddr = testutiltx.GenerateAddress()
+			amount = []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}}
+		})
+
+		Describe("Execute CreateContinuousVestingAccount transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = vesting.CreateContinuousVestingAccountMethod })
+
+			It("creates a continuous vesting account holding the vesting coins", func() {
+				endTime := s.network.GetContext().BlockTime().Unix() + 1000
+				callArgs.Args = []interface{}{funderAddr, vestingAddr, amount, endTime}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreateVestingAccount)
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				var success bool
+				Expect(s.precompile.UnpackIntoInterface(&success, vesting.CreateContinuousVestingAccountMethod, ethRes.Ret)).To(BeNil())
+				Expect(success).To(BeTrue())
+
+				ctx := s.network.GetContext()
+				vestingAcc := vestingAccount()
+				continuousAcc, ok := vestingAcc.(*vestingtypes.ContinuousVestingAccount)
+				Expect(ok).To(BeTrue(), "account should be a continuous vesting account, got %T", vestingAcc)
+				Expect(continuousAcc.GetOriginalVesting()).To(Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amountInt(amount)))))
+				Expect(continuousAcc.GetStartTime()).To(Equal(ctx.BlockTime().Unix()))
+				Expect(continuousAcc.GetEndTime()).To(Equal(endTime))
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddr.Bytes(), s.network.GetBaseDenom())
+				Expect(balance.Amount).To(Equal(amountInt(amount)))
+
+				schedule := querySchedule()
+				Expect(schedule.AccountType).To(Equal(vesting.AccountTypeContinuous))
+				Expect(schedule.OriginalVesting).To(Equal(cmn.NewCoinsResponse(continuousAcc.GetOriginalVesting())))
+				Expect(schedule.StartTime).To(Equal(continuousAcc.GetStartTime()))
+				Expect(schedule.EndTime).To(Equal(endTime))
+
+				balances := queryBalances()
+				Expect(balances.Locked).To(Equal(cmn.NewCoinsResponse(continuousAcc.LockedCoins(ctx.BlockTime()))))
+				Expect(balances.Unvested).To(Equal(cmn.NewCoinsResponse(continuousAcc.GetVestingCoins(ctx.BlockTime()))))
+				Expect(balances.Vested).To(Equal(cmn.NewCoinsResponse(continuousAcc.GetVestedCoins(ctx.BlockTime()))))
+			})
+
+			It("fails if the funder is not the caller", func() {
+				endTime := s.network.GetContext().BlockTime().Unix() + 1000
+				callArgs.Args = []interface{}{s.keyring.GetAddr(1), vestingAddr, amount, endTime}
+				errCheck := defaultLogCheck.WithErrContains(
+					cmn.ErrRequesterIsNotMsgSender,
+					funderAddr.String(),
+					s.keyring.GetAddr(1).String(),
+				)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+				Expect(acc).To(BeNil(), "vesting account should not be created")
+			})
+		})
+
+		Describe("Execute CreateDelayedVestingAccount transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = vesting.CreateDelayedVestingAccountMethod })
+
+			It("creates a delayed vesting account whose coins are all locked", func() {
+				endTime := s.network.GetContext().BlockTime().Unix() + 1000
+				callArgs.Args = []interface{}{funderAddr, vestingAddr, amount, endTime}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreateVestingAccount)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				ctx := s.network.GetContext()
+				vestingAcc := vestingAccount()
+				delayedAcc, ok := vestingAcc.(*vestingtypes.DelayedVestingAccount)
+				Expect(ok).To(BeTrue(), "account should be a delayed vesting account, got %T", vestingAcc)
+				Expect(delayedAcc.GetEndTime()).To(Equal(endTime))
+
+				// all the coins are held by the account but none can be spent before the end time
+				bankKeeper := s.network.App.GetBankKeeper()
+				Expect(bankKeeper.GetBalance(ctx, vestingAddr.Bytes(), s.network.GetBaseDenom()).Amount).To(Equal(amountInt(amount)))
+				Expect(bankKeeper.SpendableCoins(ctx, vestingAddr.Bytes()).IsZero()).To(BeTrue())
+				Expect(s.network.App.GetEVMKeeper().GetAccount(ctx, vestingAddr).Balance.IsZero()).To(BeTrue(), "locked coins should not be part of the EVM balance")
+
+				schedule := querySchedule()
+				Expect(schedule.AccountType).To(Equal(vesting.AccountTypeDelayed))
+				Expect(schedule.OriginalVesting).To(Equal(amount))
+				Expect(schedule.StartTime).To(Equal(int64(0)))
+				Expect(schedule.EndTime).To(Equal(endTime))
+
+				balances := queryBalances()
+				Expect(balances.Locked).To(Equal(amount))
+				Expect(balances.Unvested).To(Equal(amount))
+				Expect(balances.Vested).To(BeEmpty())
+			})
+		})
+
+		Describe("Execute CreatePeriodicVestingAccount transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = vesting.CreatePeriodicVestingAccountMethod })
+
+			It("creates a periodic vesting account with the given periods", func() {
+				startTime := s.network.GetContext().BlockTime().Unix()
+				half := []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(5e17)}}
+				periods := []vesting.Period{
+					{Length: 100, Amount: half},
+					{Length: 200, Amount: half},
+				}
+				callArgs.Args = []interface{}{funderAddr, vestingAddr, startTime, periods}
+				eventCheck := passCheck.WithExpEvents(vesting.EventTypeCreateVestingAccount)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				ctx := s.network.GetContext()
+				vestingAcc := vestingAccount()
+				periodicAcc, ok := vestingAcc.(*vestingtypes.PeriodicVestingAccount)
+				Expect(ok).To(BeTrue(), "account should be a periodic vesting account, got %T", vestingAcc)
+				Expect(periodicAcc.GetStartTime()).To(Equal(startTime))
+				Expect(periodicAcc.GetEndTime()).To(Equal(startTime + 300))
+				Expect(periodicAcc.VestingPeriods).To(HaveLen(2))
+				Expect(periodicAcc.GetOriginalVesting()).To(Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amountInt(amount)))))
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, vestingAddr.Bytes(), s.network.GetBaseDenom())
+				Expect(balance.Amount).To(Equal(amountInt(amount)))
+
+				schedule := querySchedule()
+				Expect(schedule.AccountType).To(Equal(vesting.AccountTypePeriodic))
+				Expect(schedule.StartTime).To(Equal(startTime))
+				Expect(schedule.EndTime).To(Equal(startTime + 300))
+				Expect(schedule.Periods).To(Equal(periods))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vesting Precompile Suite")
+}
+
+// amountInt returns the amount of the single coin of the given coins.
+func amountInt(coins []cmn.Coin) math.Int {
+	return math.NewIntFromBigInt(coins[0].Amount)
+}
@@ -553,7 +553,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, nil)
 
 			if tc.expPass {
 				s.Require().NoError(err)
@@ -763,7 +763,7 @@ func (s *TestSuite) TestEstimateGas() {
 
 			blockNum := rpctypes.BlockNumber(1)
 			blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
-			gas, err := s.backend.EstimateGas(tc.callArgs, &blockNrOrHash, tc.overrides)
+			gas, err := s.backend.EstimateGas(tc.callArgs, &blockNrOrHash, tc.overrides, nil)
 
 			if tc.expPass {
 				s.Require().NoError(err)
@@ -2,6 +2,8 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -97,6 +99,17 @@ func RegisterStatusError(client *mocks.Client) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterDumpConsensusState(client *mocks.Client, peerHeights ...int64) {
+	res := &cmtrpctypes.ResultDumpConsensusState{}
+	for _, height := range peerHeights {
+		res.Peers = append(res.Peers, cmtrpctypes.PeerStateInfo{
+			PeerState: json.RawMessage(fmt.Sprintf(`{"round_state":{"height":"%d"}}`, height)),
+		})
+	}
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(res, nil)
+}
+
 // Block
 
 func RegisterBlockMultipleTxs(
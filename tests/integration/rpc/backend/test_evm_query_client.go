@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -98,6 +99,20 @@ func RegisterTraceCallWithTracer(queryClient *mocks.EVMQueryClient, msgEthTx *ev
 	})).Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
 }
 
+func RegisterTraceCallWithOverrides(queryClient *mocks.EVMQueryClient, overrides *rpc.StateOverride, blockOverrides *rpc.BlockOverrides) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0x7d} // {"test": "trace_call"}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), mock.MatchedBy(func(req *evmtypes.QueryTraceCallRequest) bool {
+		var (
+			reqOverrides      rpc.StateOverride
+			reqBlockOverrides rpc.BlockOverrides
+		)
+		if json.Unmarshal(req.Overrides, &reqOverrides) != nil || json.Unmarshal(req.BlockOverrides, &reqBlockOverrides) != nil {
+			return false
+		}
+		return reflect.DeepEqual(*overrides, reqOverrides) && reflect.DeepEqual(*blockOverrides, reqBlockOverrides)
+	})).Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
 func RegisterTraceCallError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("TraceCall", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryTraceCallRequest")).
 		Return(nil, errortypes.ErrInvalidRequest)
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"database/sql"
 	"fmt"
 	"math/big"
 
@@ -9,10 +10,15 @@ import (
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/metadata"
 
+	_ "modernc.org/sqlite"
+
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -196,12 +202,32 @@ func (s *TestSuite) TestSyncing() {
 			func() {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				RegisterStatus(client)
+				RegisterDumpConsensusState(client)
 				status, _ := client.Status(s.backend.Ctx)
 				status.SyncInfo.CatchingUp = true
 			},
 			map[string]interface{}{
 				"startingBlock": hexutil.Uint64(0),
 				"currentBlock":  hexutil.Uint64(0),
+				"highestBlock":  hexutil.Uint64(0),
+			},
+			true,
+		},
+		{
+			"pass - Node is catching up with the highest block of the peers",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				RegisterDumpConsensusState(client, 5, 11, 8)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 6
+			},
+			map[string]interface{}{
+				"startingBlock": hexutil.Uint64(1),
+				"currentBlock":  hexutil.Uint64(6),
+				"highestBlock":  hexutil.Uint64(10),
 			},
 			true,
 		},
@@ -224,6 +250,80 @@ func (s *TestSuite) TestSyncing() {
 	}
 }
 
+func (s *TestSuite) TestIndexerStatus() {
+	indexedBlock := hexutil.Uint64(1)
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResponse  *rpctypes.IndexerStatus
+		expPass      bool
+	}{
+		{
+			"fail - Can't get status",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatusError(client)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - No block indexed",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.LatestBlockHeight = 3
+			},
+			&rpctypes.IndexerStatus{LatestBlock: 3, Lag: 3},
+			true,
+		},
+		{
+			"pass - Indexer behind the latest block",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.LatestBlockHeight = 3
+				// the sql indexer records the blocks without eth txs
+				db, err := sql.Open(indexer.SQLDriverSQLite, ":memory:")
+				s.Require().NoError(err)
+				db.SetMaxOpenConns(1)
+				idxer, err := indexer.NewSQLIndexer(db, indexer.SQLDriverSQLite, s.backend.Logger, s.backend.ClientCtx)
+				s.Require().NoError(err)
+				s.Require().NoError(idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil))
+				s.backend.Indexer = idxer
+			},
+			&rpctypes.IndexerStatus{LatestBlock: 3, IndexedBlock: &indexedBlock, Lag: 2},
+			true,
+		},
+		{
+			"pass - Indexer disabled",
+			func() {
+				s.backend.Indexer = nil
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			output, err := s.backend.IndexerStatus()
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResponse, output)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestSetEtherbase() {
 	testCases := []struct {
 		name         string
@@ -2,8 +2,10 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -210,13 +212,16 @@ func (s *TestSuite) TestTraceTransaction() {
 
 func (s *TestSuite) TestTraceCall() {
 	msgEthTx, _ := s.buildEthereumTx()
+	nonce := hexutil.Uint64(1)
+	stateOverrides := rpctypes.StateOverride{common.Address{0x2}: {Nonce: &nonce}}
+	blockOverrides := rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(100))}
 
 	testCases := []struct {
 		name          string
 		registerMock  func()
 		args          evmtypes.TransactionArgs
 		blockNrOrHash rpctypes.BlockNumberOrHash
-		config        *rpctypes.TraceConfig
+		config        *rpctypes.TraceCallConfig
 		expResult     interface{}
 		expPass       bool
 	}{
@@ -241,7 +246,7 @@ func (s *TestSuite) TestTraceCall() {
 					return &bn
 				}(),
 			},
-			&rpctypes.TraceConfig{},
+			&rpctypes.TraceCallConfig{},
 			map[string]interface{}{"test": "trace_call"},
 			true,
 		},
@@ -268,7 +273,7 @@ func (s *TestSuite) TestTraceCall() {
 					return &h
 				}(),
 			},
-			&rpctypes.TraceConfig{},
+			&rpctypes.TraceCallConfig{},
 			map[string]interface{}{"test": "trace_call"},
 			true,
 		},
@@ -293,14 +298,44 @@ func (s *TestSuite) TestTraceCall() {
 					return &bn
 				}(),
 			},
-			&rpctypes.TraceConfig{
-				TraceConfig: evmtypes.TraceConfig{
-					Tracer: "callTracer",
+			&rpctypes.TraceCallConfig{
+				TraceConfig: rpctypes.TraceConfig{
+					TraceConfig: evmtypes.TraceConfig{
+						Tracer: "callTracer",
+					},
 				},
 			},
 			map[string]interface{}{"type": "CALL"},
 			true,
 		},
+		{
+			"pass - trace call with state and block overrides",
+			func() {
+				var (
+					QueryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+					height      = int64(1)
+				)
+				RegisterHeader(client, &height, nil)
+				RegisterTraceCallWithOverrides(QueryClient, &stateOverrides, &blockOverrides)
+			},
+			evmtypes.TransactionArgs{
+				From: &common.Address{0x1},
+				To:   &common.Address{0x2},
+			},
+			rpctypes.BlockNumberOrHash{
+				BlockNumber: func() *rpctypes.BlockNumber {
+					bn := rpctypes.BlockNumber(1)
+					return &bn
+				}(),
+			},
+			&rpctypes.TraceCallConfig{
+				StateOverrides: &stateOverrides,
+				BlockOverrides: &blockOverrides,
+			},
+			map[string]interface{}{"test": "trace_call"},
+			true,
+		},
 		{
 			"fail - invalid block number or hash",
 			func() {},
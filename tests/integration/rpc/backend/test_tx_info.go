@@ -187,6 +187,125 @@ func (s *TestSuite) TestGetTransactionsByHashPending() {
 	}
 }
 
+func (s *TestSuite) TestEthTransactionByHash() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		indexed      bool
+		expTx        bool
+		expPass      bool
+	}{
+		{
+			"fail - Block error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"pass - Block of the transaction pruned returns nil",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockNotFound(client, 1)
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"pass - Transaction index out of the block returns nil",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"pass - Transaction found in a block",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+			},
+			true,
+			true,
+			true,
+		},
+		{
+			"pass - Transaction found in the mempool",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{txBz})
+			},
+			false,
+			true,
+			true,
+		},
+		{
+			"pass - Transaction not found returns nil",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			false,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			s.backend.Indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), s.backend.ClientCtx)
+			if tc.indexed {
+				err := s.backend.Indexer.IndexBlock(block, responseDeliver)
+				s.Require().NoError(err)
+			}
+
+			tx, err := s.backend.EthTransactionByHash(txHash)
+
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			if !tc.expTx {
+				s.Require().Nil(tx)
+				return
+			}
+			s.Require().Equal(txHash, tx.Hash())
+		})
+	}
+}
+
 func (s *TestSuite) TestGetTxByEthHash() {
 	msgEthereumTx, bz := s.buildEthereumTx()
 	rpcTransaction := rpctypes.NewRPCTransaction(msgEthereumTx.AsTransaction(), common.Hash{}, 0, 0, 0, big.NewInt(1), s.backend.ChainConfig())
@@ -706,6 +825,103 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *TestSuite) TestGetTransactionsByAddress() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.Hash()
+	from := msgEthereumTx.GetSender()
+
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+	limit := hexutil.Uint64(1)
+
+	testCases := []struct {
+		name         string
+		addressIndex bool
+		address      common.Address
+		args         rpctypes.AddressTxArgs
+		expTxs       []rpctypes.AddressTx
+		expErr       string
+	}{
+		{
+			"fail - address index disabled",
+			false,
+			from,
+			rpctypes.AddressTxArgs{},
+			nil,
+			indexer.ErrAddressIndexDisabled.Error(),
+		},
+		{
+			"fail - unknown role",
+			true,
+			from,
+			rpctypes.AddressTxArgs{Roles: []string{"miner"}},
+			nil,
+			"unknown address role",
+		},
+		{
+			"pass - sender",
+			true,
+			from,
+			rpctypes.AddressTxArgs{Limit: &limit},
+			[]rpctypes.AddressTx{{Hash: txHash, BlockNumber: 1, Roles: []string{rpctypes.AddressTxRoleFrom}}},
+			"",
+		},
+		{
+			"pass - recipient",
+			true,
+			common.Address{},
+			rpctypes.AddressTxArgs{Roles: []string{rpctypes.AddressTxRoleTo}, Descending: true},
+			[]rpctypes.AddressTx{{Hash: txHash, BlockNumber: 1, Roles: []string{rpctypes.AddressTxRoleTo}}},
+			"",
+		},
+		{
+			"pass - role not matching",
+			true,
+			common.Address{},
+			rpctypes.AddressTxArgs{Roles: []string{rpctypes.AddressTxRoleFrom, rpctypes.AddressTxRoleCreated}},
+			[]rpctypes.AddressTx{},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			var opts []indexer.KVIndexerOption
+			if tc.addressIndex {
+				opts = append(opts, indexer.WithAddressIndex())
+			}
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx, opts...)
+			s.Require().NoError(s.backend.Indexer.IndexBlock(block, blockResult))
+
+			res, err := s.backend.GetTransactionsByAddress(tc.address, tc.args)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTxs, res.Transactions)
+			s.Require().Nil(res.Next)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetGasUsed() {
 	testCases := []struct {
 		name     string
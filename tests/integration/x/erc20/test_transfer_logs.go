@@ -0,0 +1,239 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/distribution"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTransferLogSendRestriction() {
+	var (
+		ctx     sdk.Context
+		pair    types.TokenPair
+		amt     sdk.Coins
+		evmLogs []*ethtypes.Log
+	)
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLog    bool
+		expEVMLog bool
+	}{
+		{
+			"native token pair",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"coin without token pair",
+			func() { amt = sdk.NewCoins(sdk.NewInt64Coin("other", 100)) },
+			false,
+			false,
+		},
+		{
+			"disabled token pair",
+			func() {
+				pair.Enabled = false
+				s.network.App.GetErc20Keeper().SetTokenPair(ctx, pair)
+			},
+			false,
+			false,
+		},
+		{
+			"ERC-20 token pair",
+			func() {
+				pair.ContractOwner = types.OWNER_EXTERNAL
+				s.network.App.GetErc20Keeper().SetTokenPair(ctx, pair)
+			},
+			false,
+			false,
+		},
+		{
+			"send outside of a transaction",
+			func() { ctx = ctx.WithTxBytes(nil) },
+			false,
+			false,
+		},
+		{
+			"send of an EVM transaction",
+			func() { ctx = evmtypes.WithEVMExecution(ctx) },
+			false,
+			false,
+		},
+		{
+			"send of a precompile",
+			func() {
+				ctx = evmtypes.WithPrecompileLogs(evmtypes.WithEVMExecution(ctx), func(log *ethtypes.Log) {
+					evmLogs = append(evmLogs, log)
+				})
+			},
+			false,
+			true,
+		},
+		{
+			"send of a precompile outside of a transaction",
+			func() {
+				ctx = evmtypes.WithPrecompileLogs(evmtypes.WithEVMExecution(ctx.WithTxBytes(nil)), func(log *ethtypes.Log) {
+					evmLogs = append(evmLogs, log)
+				})
+			},
+			false,
+			true,
+		},
+		{
+			"send of a precompile emitting its own Transfer log",
+			func() { ctx = evmtypes.WithPrecompileLogs(evmtypes.WithEVMExecution(ctx), nil) },
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext().WithTxBytes([]byte("tx")).WithEventManager(sdk.NewEventManager())
+
+			pair = types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+			s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+			amt = sdk.NewCoins(sdk.NewCoin("coin", math.NewInt(100)))
+			evmLogs = nil
+			logSize := s.network.App.GetEVMKeeper().GetLogSizeTransient(ctx)
+
+			tc.malleate()
+
+			newTo, err := s.network.App.GetErc20Keeper().TransferLogSendRestriction(ctx, from.Bytes(), to.Bytes(), amt)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.AccAddress(to.Bytes()), newTo)
+
+			logs, err := evmtypes.DecodeSystemLogs(ctx.EventManager().ABCIEvents())
+			s.Require().NoError(err)
+			if tc.expEVMLog {
+				// the log is added to the EVM transaction calling the precompile
+				s.Require().Empty(logs)
+				s.Require().Len(evmLogs, 1)
+				s.Require().Equal(pair.GetERC20Contract(), evmLogs[0].Address)
+				s.Require().Equal(common.BytesToHash(to.Bytes()), evmLogs[0].Topics[2])
+				s.Require().Equal(big.NewInt(100), new(big.Int).SetBytes(evmLogs[0].Data))
+				s.Require().Equal(logSize, s.network.App.GetEVMKeeper().GetLogSizeTransient(ctx))
+				return
+			}
+			s.Require().Empty(evmLogs)
+			if !tc.expLog {
+				s.Require().Empty(logs)
+				return
+			}
+
+			s.Require().Len(logs, 1)
+			log := logs[0]
+			s.Require().Equal(pair.GetERC20Contract(), log.Address)
+			s.Require().Equal([]common.Hash{
+				crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			}, log.Topics)
+			s.Require().Equal(big.NewInt(100), new(big.Int).SetBytes(log.Data))
+			s.Require().Equal(uint64(ctx.BlockHeight()), log.BlockNumber) //nolint:gosec // G115
+			s.Require().Equal(uint(logSize), log.Index)
+
+			// the log takes a log index and is added to the block bloom
+			evmKeeper := s.network.App.GetEVMKeeper()
+			s.Require().Equal(logSize+1, evmKeeper.GetLogSizeTransient(ctx))
+			bloom := ethtypes.BytesToBloom(evmKeeper.GetBlockBloomTransient(ctx).Bytes())
+			s.Require().True(bloom.Test(pair.GetERC20Contract().Bytes()))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPrecompileTransferLogs() {
+	s.SetupTest()
+	depositor := s.keyring.GetKey(0)
+	amount := big.NewInt(1e18)
+
+	erc20Keeper := s.network.App.GetErc20Keeper()
+	// the Transfer logs are opt-in, so the chain appends the send restriction
+	s.network.App.GetBankKeeper().AppendSendRestriction(erc20Keeper.TransferLogSendRestriction)
+	ctx := s.network.GetContext()
+	pair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, s.network.GetBaseDenom()))
+	s.Require().True(found)
+
+	// the community pool funding of the distribution precompile is reported as
+	// a Transfer log of the EVM transaction
+	distributionAddr := common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+	res, err := s.factory.ExecuteContractCall(
+		depositor.Priv,
+		evmtypes.EvmTxArgs{To: &distributionAddr, GasLimit: 200_000},
+		testutiltypes.CallArgs{
+			ContractABI: distribution.ABI,
+			MethodName:  distribution.FundCommunityPoolMethod,
+			Args: []interface{}{
+				depositor.Addr,
+				[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: amount}},
+			},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), res.Log)
+	s.Require().NoError(s.network.NextBlock())
+
+	ethRes, err := evmtypes.DecodeTxResponse(res.Data)
+	s.Require().NoError(err)
+	var transferLogs []*ethtypes.Log
+	for i, log := range evmtypes.LogsToEthereum(ethRes.Logs) {
+		s.Require().Equal(uint(i), log.Index)
+		if log.Address == pair.GetERC20Contract() {
+			transferLogs = append(transferLogs, log)
+		}
+	}
+	s.Require().Len(transferLogs, 1)
+	s.Require().Equal([]common.Hash{
+		crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+		common.BytesToHash(depositor.AccAddr.Bytes()),
+		common.BytesToHash(authtypes.NewModuleAddress(distrtypes.ModuleName).Bytes()),
+	}, transferLogs[0].Topics)
+	s.Require().Equal(amount, new(big.Int).SetBytes(transferLogs[0].Data))
+}
+
+// TestTransferLogsSkipDelegations tests that the delegated and undelegated coins
+// are not logged, since the bank keeper doesn't apply the send restrictions to
+// them.
+func (s *KeeperTestSuite) TestTransferLogsSkipDelegations() {
+	s.SetupTest()
+	delegator := s.keyring.GetKey(0)
+	bankKeeper := s.network.App.GetBankKeeper()
+	bankKeeper.AppendSendRestriction(s.network.App.GetErc20Keeper().TransferLogSendRestriction)
+
+	ctx := s.network.GetContext().WithTxBytes([]byte("tx")).WithEventManager(sdk.NewEventManager())
+	amt := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(100)))
+
+	s.Require().NoError(bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegator.AccAddr, stakingtypes.BondedPoolName, amt))
+	s.Require().NoError(bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, delegator.AccAddr, amt))
+	logs, err := evmtypes.DecodeSystemLogs(ctx.EventManager().ABCIEvents())
+	s.Require().NoError(err)
+	s.Require().Empty(logs)
+
+	// a send of the same coins is logged
+	s.Require().NoError(bankKeeper.SendCoins(ctx, delegator.AccAddr, s.keyring.GetKey(1).AccAddr, amt))
+	logs, err = evmtypes.DecodeSystemLogs(ctx.EventManager().ABCIEvents())
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+}
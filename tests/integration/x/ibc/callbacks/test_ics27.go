@@ -0,0 +1,180 @@
+package callbacks
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// icaPacket returns an ICS-27 packet sent from the controller port of the given
+// owner with the given memo.
+func icaPacket(owner, memo string) channeltypes.Packet {
+	data := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("msgs"),
+		Memo: memo,
+	}
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		1,
+		icatypes.ControllerPortPrefix+owner,
+		"channel-0",
+		icatypes.HostPortID,
+		"channel-1",
+		clienttypes.ZeroHeight(),
+		10000000,
+	)
+}
+
+func (s *KeeperTestSuite) TestUnmarshalSourcePacketData() {
+	sender := s.keyring.GetKey(0).AccAddr.String()
+	memo := `{"src_callback": {"address": "0x1234567890abcdef1234567890abcdef12345678"}}`
+
+	testCases := []struct {
+		name     string
+		packet   func() channeltypes.Packet
+		version  string
+		expData  func() any
+		expError bool
+	}{
+		{
+			"ICS-27 controller packet",
+			func() channeltypes.Packet {
+				return icaPacket(sender, memo)
+			},
+			icatypes.NewDefaultMetadataString("connection-0", "connection-1"),
+			func() any {
+				return icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("msgs"),
+					Memo: memo,
+				}
+			},
+			false,
+		},
+		{
+			"ICS-27 controller packet with invalid data",
+			func() channeltypes.Packet {
+				packet := icaPacket(sender, memo)
+				packet.Data = []byte("not an ICA packet")
+				return packet
+			},
+			icatypes.NewDefaultMetadataString("connection-0", "connection-1"),
+			nil,
+			true,
+		},
+		{
+			"ICS-20 transfer packet",
+			func() channeltypes.Packet {
+				data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, sender, memo)
+				return channeltypes.NewPacket(
+					data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1",
+					clienttypes.ZeroHeight(), 10000000,
+				)
+			},
+			transfertypes.V1,
+			func() any {
+				data, err := transfertypes.UnmarshalPacketData(
+					transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, sender, memo).GetBytes(),
+					transfertypes.V1, "",
+				)
+				s.Require().NoError(err)
+				return data
+			},
+			false,
+		},
+		{
+			"ICA packet data on a transfer port",
+			func() channeltypes.Packet {
+				packet := icaPacket(sender, memo)
+				packet.SourcePort = transfertypes.PortID
+				return packet
+			},
+			transfertypes.V1,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			data, err := types.UnmarshalSourcePacketData(tc.packet(), tc.version)
+			if tc.expError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expData(), data)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestICS27SourceCallbacks() {
+	testCases := []struct {
+		name       string
+		callback   func(packet channeltypes.Packet, contract common.Address) error
+		expCounter int64
+	}{
+		{
+			"acknowledgement",
+			func(packet channeltypes.Packet, contract common.Address) error {
+				senderKey := s.keyring.GetKey(0)
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				return s.network.App.GetCallbackKeeper().IBCOnAcknowledgementPacketCallback(
+					s.network.GetContext(), packet, ack.Acknowledgement(), senderKey.AccAddr, contract.Hex(),
+					senderKey.AccAddr.String(), icatypes.NewDefaultMetadataString("connection-0", "connection-1"),
+				)
+			},
+			1,
+		},
+		{
+			"timeout",
+			func(packet channeltypes.Packet, contract common.Address) error {
+				senderKey := s.keyring.GetKey(0)
+				return s.network.App.GetCallbackKeeper().IBCOnTimeoutPacketCallback(
+					s.network.GetContext(), packet, senderKey.AccAddr, contract.Hex(),
+					senderKey.AccAddr.String(), icatypes.NewDefaultMetadataString("connection-0", "connection-1"),
+				)
+			},
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			senderKey := s.keyring.GetKey(0)
+			contractData, err := testutil.LoadCounterWithCallbacksContract()
+			s.Require().NoError(err)
+			contract, err := s.factory.DeployContract(
+				senderKey.Priv,
+				evmtypes.EvmTxArgs{},
+				testutiltypes.ContractDeploymentData{Contract: contractData},
+			)
+			s.Require().NoError(err)
+			s.Require().NoError(s.network.NextBlock())
+
+			packet := icaPacket(senderKey.AccAddr.String(), fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex()))
+			s.Require().NoError(tc.callback(packet, contract))
+
+			res, err := s.network.App.GetEVMKeeper().CallEVM(
+				s.network.GetContext(), contractData.ABI, senderKey.Addr, contract, false, big.NewInt(100000), "getCounter",
+			)
+			s.Require().NoError(err)
+			var counter *big.Int
+			s.Require().NoError(contractData.ABI.UnpackIntoInterface(&counter, "getCounter", res.Ret))
+			s.Require().Equal(tc.expCounter, counter.Int64())
+		})
+	}
+}
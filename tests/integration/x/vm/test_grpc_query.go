@@ -18,6 +18,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -2211,6 +2212,282 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCallWithBlockOverrides() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contractAddr := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr})
+	s.Require().NoError(err)
+
+	// the contract returns the block number: NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	stateOverrides := []byte(fmt.Sprintf(`{"%s": {"code": "0x4360005260206000f3"}}`, contractAddr.Hex()))
+	blockOverrides := []byte(`{"number": "0x1234"}`)
+	expRet := common.LeftPadBytes([]byte{0x12, 0x34}, 32)
+
+	s.Run("eth_call", func() {
+		res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), &types.EthCallRequest{
+			Args:           args,
+			GasCap:         config.DefaultGasCap,
+			Overrides:      stateOverrides,
+			BlockOverrides: blockOverrides,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(res.VmError)
+		s.Require().Equal(expRet, res.Ret)
+	})
+
+	s.Run("eth_call - invalid block overrides", func() {
+		_, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), &types.EthCallRequest{
+			Args:           args,
+			GasCap:         config.DefaultGasCap,
+			BlockOverrides: []byte("invalid"),
+		})
+		s.Require().ErrorContains(err, "invalid block overrides format")
+	})
+
+	s.Run("debug_traceCall", func() {
+		ctx := s.Network.GetContext()
+		res, err := s.Network.GetEvmClient().TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:            args,
+			GasCap:          config.DefaultGasCap,
+			TraceConfig:     &types.TraceConfig{Tracer: "callTracer"},
+			BlockNumber:     ctx.BlockHeight(),
+			BlockTime:       ctx.BlockTime(),
+			BlockHash:       common.BytesToHash(ctx.HeaderHash()).Hex(),
+			ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+			ChainId:         s.Network.GetEIP155ChainID().Int64(),
+			Overrides:       stateOverrides,
+			BlockOverrides:  blockOverrides,
+		})
+		s.Require().NoError(err)
+
+		var result map[string]interface{}
+		s.Require().NoError(json.Unmarshal(res.Data, &result))
+		s.Require().Equal(hexutil.Encode(expRet), result["output"])
+	})
+}
+
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	amount := (*hexutil.Big)(big.NewInt(1000))
+
+	marshalOpts := func(opts rpctypes.SimOpts) []byte {
+		bz, err := json.Marshal(opts)
+		s.Require().NoError(err)
+		return bz
+	}
+	height := s.Network.GetContext().BlockHeight()
+	prevRandao := common.HexToHash("0x0123456789abcdef")
+
+	testCases := []struct {
+		name         string
+		getOpts      func() []byte
+		expPass      bool
+		expErrorCode int32
+		malleate     func(blocks []rpctypes.SimBlockResult)
+	}{
+		{
+			"fail - invalid opts",
+			func() []byte { return []byte("invalid opts") },
+			false,
+			0,
+			nil,
+		},
+		{
+			"fail - no blocks",
+			func() []byte { return marshalOpts(rpctypes.SimOpts{}) },
+			false,
+			0,
+			nil,
+		},
+		{
+			"fail - block numbers not in order",
+			func() []byte {
+				return marshalOpts(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(height))}},
+				}})
+			},
+			true,
+			rpctypes.ErrCodeBlockNumberInvalid,
+			nil,
+		},
+		{
+			"fail - nonce too high with validation",
+			func() []byte {
+				nonce := hexutil.Uint64(100)
+				return marshalOpts(rpctypes.SimOpts{
+					Validation: true,
+					BlockStateCalls: []rpctypes.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: amount, Nonce: &nonce}}},
+					},
+				})
+			},
+			true,
+			rpctypes.ErrCodeNonceTooHigh,
+			nil,
+		},
+		{
+			"pass - state is carried over to the next block",
+			func() []byte {
+				return marshalOpts(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: amount}}},
+					{Calls: []types.TransactionArgs{{From: &recipient, To: &sender, Value: amount}}},
+				}})
+			},
+			true,
+			0,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 2)
+				for i, block := range blocks {
+					s.Require().Equal(height+int64(i)+1, block.Header.Number.Int64())
+					s.Require().Len(block.Calls, 1)
+					s.Require().Equal(hexutil.Uint64(1), block.Calls[0].Status)
+					s.Require().Nil(block.Calls[0].Error)
+				}
+				s.Require().Equal(blocks[0].Header.Hash(), blocks[1].Header.ParentHash)
+				s.Require().Equal(recipient, blocks[1].Senders[0])
+			},
+		},
+		{
+			"pass - gaps between blocks are filled",
+			func() []byte {
+				return marshalOpts(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(height + 3))}},
+				}})
+			},
+			true,
+			0,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 3)
+				s.Require().Equal(height+3, blocks[2].Header.Number.Int64())
+				s.Require().Greater(blocks[2].Header.Time, blocks[1].Header.Time)
+			},
+		},
+		{
+			"pass - trace transfers",
+			func() []byte {
+				return marshalOpts(rpctypes.SimOpts{
+					TraceTransfers: true,
+					BlockStateCalls: []rpctypes.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: amount}}},
+					},
+				})
+			},
+			true,
+			0,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 1)
+				logs := blocks[0].Calls[0].Logs
+				s.Require().Len(logs, 1)
+				s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), logs[0].Address)
+				s.Require().Equal(common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+				s.Require().Equal(blocks[0].Header.Hash(), logs[0].BlockHash)
+			},
+		},
+		{
+			"pass - prevRandao override is returned by PREVRANDAO",
+			func() []byte {
+				// PREVRANDAO PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+				code := hexutil.Bytes{0x44, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+				return marshalOpts(rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+					{
+						BlockOverrides: &rpctypes.BlockOverrides{PrevRandao: &prevRandao},
+						StateOverrides: &rpctypes.StateOverride{recipient: {Code: &code}},
+						Calls:          []types.TransactionArgs{{From: &sender, To: &recipient}},
+					},
+				}})
+			},
+			true,
+			0,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 1)
+				s.Require().Equal(prevRandao, blocks[0].Header.MixDigest)
+				s.Require().Nil(blocks[0].Calls[0].Error)
+				s.Require().Equal(prevRandao.Bytes(), []byte(blocks[0].Calls[0].ReturnValue))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := &types.SimulateV1Request{Opts: tc.getOpts(), GasCap: config.DefaultGasCap}
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expErrorCode, res.ErrorCode, res.Error)
+			if tc.malleate == nil {
+				return
+			}
+
+			var blocks []rpctypes.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+			tc.malleate(blocks)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateV1Limits() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	transfer := types.TransactionArgs{From: &sender, To: &recipient, Value: (*hexutil.Big)(big.NewInt(1000))}
+
+	testCases := []struct {
+		name         string
+		gasCap       uint64
+		blocks       []rpctypes.SimBlock
+		expErrorCode int32
+	}{
+		{
+			"pass - calls within the request gas cap",
+			2 * ethparams.TxGas,
+			[]rpctypes.SimBlock{
+				{Calls: []types.TransactionArgs{transfer}},
+				{Calls: []types.TransactionArgs{transfer}},
+			},
+			0,
+		},
+		{
+			"fail - the gas cap is shared by all the calls of the request",
+			2 * ethparams.TxGas,
+			[]rpctypes.SimBlock{
+				{Calls: []types.TransactionArgs{transfer, transfer}},
+				{Calls: []types.TransactionArgs{transfer}},
+			},
+			rpctypes.ErrCodeClientLimitExceeded,
+		},
+		{
+			"fail - too many calls",
+			config.DefaultGasCap,
+			[]rpctypes.SimBlock{
+				{Calls: make([]types.TransactionArgs, 600)},
+				{Calls: make([]types.TransactionArgs, 600)},
+			},
+			rpctypes.ErrCodeClientLimitExceeded,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(rpctypes.SimOpts{BlockStateCalls: tc.blocks})
+			s.Require().NoError(err)
+			req := &types.SimulateV1Request{Opts: opts, GasCap: tc.gasCap}
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expErrorCode, res.ErrorCode, res.Error)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -2420,6 +2697,12 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1134,6 +1134,7 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 				txConfig,
 				false,
 				tc.overrides,
+				nil,
 			)
 
 			if tc.expErr {
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferLogSendRestriction is a bank send restriction emitting an ERC-20
+// Transfer log for the coins of the enabled native token pairs moved outside of
+// the EVM, e.g. by bank sends, IBC transfers or staking reward withdrawals. The
+// logs are returned by eth_getLogs, so that ERC-20 indexers and wallets see the
+// balance changes of the ERC-20 precompiles. It never restricts a send.
+//
+// It is opt-in: chains enable it by appending it to the send restrictions of
+// the bank keeper, which evmd does when evm.enable-transfer-logs is set. The sends of Cosmos transactions are reported with system
+// logs, while the ones of the precompiles are added to the logs of the EVM
+// transaction calling them. The balance changes of the EVM itself are skipped,
+// since they are already reflected by the EVM logs, as are the sends of the
+// precompiles emitting their own Transfer logs and the ones of the begin and
+// end blockers, which don't belong to a transaction.
+//
+// The bank keeper doesn't apply the send restrictions to DelegateCoins and
+// UndelegateCoins, so the delegations and undelegations of the staking module,
+// including the ones of vesting accounts, are not logged.
+func (k Keeper) TransferLogSendRestriction(goCtx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addLog := evmtypes.PrecompileLogs(ctx)
+	if addLog == nil && (len(ctx.TxBytes()) == 0 || evmtypes.IsEVMExecution(ctx)) {
+		return to, nil
+	}
+
+	for _, coin := range amt {
+		if !coin.Amount.IsPositive() {
+			continue
+		}
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+		if !found || !pair.Enabled || !pair.IsNativeCoin() {
+			continue
+		}
+
+		log := &ethtypes.Log{
+			Address: pair.GetERC20Contract(),
+			Topics: []common.Hash{
+				logTransferSigHash,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.LeftPadBytes(coin.Amount.BigInt().Bytes(), 32),
+		}
+		if addLog != nil {
+			log.BlockNumber = uint64(ctx.BlockHeight()) //nolint:gosec // G115 // won't exceed uint64
+			addLog(log)
+			continue
+		}
+		if err := k.evmKeeper.EmitSystemLog(ctx, log); err != nil {
+			return nil, err
+		}
+	}
+	return to, nil
+}
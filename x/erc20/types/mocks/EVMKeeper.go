@@ -19,6 +19,8 @@ import (
 
 	tracing "github.com/ethereum/go-ethereum/core/tracing"
 
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+
 	types "github.com/cosmos/cosmos-sdk/types"
 
 	vmtypes "github.com/cosmos/evm/x/vm/types"
@@ -140,6 +142,24 @@ func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error
 	return r0
 }
 
+// EmitSystemLog provides a mock function with given fields: ctx, log
+func (_m *EVMKeeper) EmitSystemLog(ctx types.Context, log *coretypes.Log) error {
+	ret := _m.Called(ctx, log)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitSystemLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *coretypes.Log) error); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EstimateGasInternal provides a mock function with given fields: c, req, fromType
 func (_m *EVMKeeper) EstimateGasInternal(c context.Context, req *vmtypes.EthCallRequest, fromType vmtypes.CallType) (*vmtypes.EstimateGasResponse, error) {
 	ret := _m.Called(c, req, fromType)
@@ -246,7 +246,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 // allowing contracts to react to successful or failed packet delivery.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS-20 transfer or ICS-27 controller packets)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (acknowledgement callbacks should not contain calldata)
 // 4. Verifies the target contract exists and contains code
@@ -276,7 +276,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -345,7 +345,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 // allowing contracts to handle timeout scenarios and perform cleanup or rollback operations.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS-20 transfer or ICS-27 controller packets)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (timeout callbacks should not contain calldata)
 // 4. Sets up a cached context with proper gas metering for EVM execution
@@ -376,7 +376,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
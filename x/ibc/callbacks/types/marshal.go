@@ -1,7 +1,11 @@
 package types
 
 import (
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,3 +27,19 @@ func (u Unmarshaler) UnmarshalPacketData(ctx sdk.Context, portID, channelID stri
 	}
 	return transferData, transfertypes.V1, nil
 }
+
+// UnmarshalSourcePacketData unmarshals the data of a packet sent from this chain
+// for the source callbacks. Packets sent from an interchain accounts controller
+// port carry ICS-27 packet data, while all other packets are expected to be
+// ICS-20 transfers.
+func UnmarshalSourcePacketData(packet channeltypes.Packet, version string) (any, error) {
+	if strings.HasPrefix(packet.GetSourcePort(), icatypes.ControllerPortPrefix) {
+		var data icatypes.InterchainAccountPacketData
+		if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	return transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+}
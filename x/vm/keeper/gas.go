@@ -28,10 +28,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee granter if the
+// fees were paid with a fee allowance, capped to half of the total gas consumed in the transaction.
+// The fee allowance isn't restored: as for the Cosmos transactions, whose fees are never refunded,
+// the allowance is used up for the gas limit of the transaction and only the granter balance is refunded.
+// Additionally, the function sets the total gas consumed to the value returned by the EVM execution,
+// thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
@@ -44,8 +46,14 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
+		// the fee granter paid the fees in the ante handler, so it gets the refund instead of the sender
+		refundAddr := sdk.AccAddress(msg.From.Bytes())
+		if feeGranter := k.GetFeeGranterTransient(ctx); !feeGranter.Empty() {
+			refundAddr = feeGranter
+		}
+
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundAddr, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
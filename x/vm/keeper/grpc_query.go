@@ -238,6 +238,14 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		}
 	}
 
+	var blockOverrides *rpctypes.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		blockOverrides = new(rpctypes.BlockOverrides)
+		if err := json.Unmarshal(req.BlockOverrides, blockOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid block overrides format: %s", err.Error()))
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var args types.TransactionArgs
@@ -263,7 +271,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	txConfig := statedb.NewEmptyTxConfig()
 
 	// pass false to not commit StateDB
-	res, err := k.ApplyMessageWithConfig(ctx, *msg, nil, false, cfg, txConfig, false, overrides)
+	res, err := k.ApplyMessageWithConfig(ctx, *msg, nil, false, cfg, txConfig, false, overrides, blockOverrides)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -294,6 +302,14 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		}
 	}
 
+	var blockOverrides *rpctypes.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		blockOverrides = new(rpctypes.BlockOverrides)
+		if err := json.Unmarshal(req.BlockOverrides, blockOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid block overrides format: %s", err.Error()))
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	if req.GasCap < ethparams.TxGas {
@@ -411,7 +427,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 			tmpCtx = buildTraceCtx(tmpCtx, msg.GasLimit)
 		}
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(tmpCtx, *msg, nil, false, cfg, txConfig, false, overrides)
+		rsp, err = k.ApplyMessageWithConfig(tmpCtx, *msg, nil, false, cfg, txConfig, false, overrides, blockOverrides)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) || errors.Is(err, core.ErrFloorDataGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -563,7 +579,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		ctx = buildTraceCtx(ctx, msg.GasLimit)
 		// we ignore the error here. this endpoint, ideally, is called internally from the ETH backend, which will call this query
 		// using all previous txs in the trace transaction's block. some of those _could_ be invalid transactions.
-		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig, false, nil)
+		rsp, _ := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig, false, nil, nil)
 		if rsp != nil {
 			ctx.GasMeter().ConsumeGas(rsp.GasUsed, "evm predecessor tx")
 			txConfig.LogIndex += uint(len(rsp.Logs))
@@ -676,6 +692,22 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	var stateOverrides *rpctypes.StateOverride
+	if len(req.Overrides) > 0 {
+		stateOverrides = new(rpctypes.StateOverride)
+		if err := json.Unmarshal(req.Overrides, stateOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid state overrides format: %s", err.Error()))
+		}
+	}
+
+	var blockOverrides *rpctypes.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		blockOverrides = new(rpctypes.BlockOverrides)
+		if err := json.Unmarshal(req.BlockOverrides, blockOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid block overrides format: %s", err.Error()))
+		}
+	}
+
 	// get the context of block beginning
 	requestedHeight := req.BlockNumber
 	if requestedHeight < 1 {
@@ -723,7 +755,7 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 	msg := args.ToMessage(baseFee, true, true)
 
 	// trace call
-	result, _, err := k.traceTxWithMsg(ctx, cfg, txConfig, msg, req.GetTraceConfig(), false)
+	result, _, err := k.traceTxWithMsg(ctx, cfg, txConfig, msg, req.GetTraceConfig(), false, stateOverrides, blockOverrides)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -754,10 +786,11 @@ func (k *Keeper) traceTx(
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
-	return k.traceTxWithMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
+	return k.traceTxWithMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, nil, nil)
 }
 
 // traceTxWithMsg do trace on one Ethereum message, it returns a tuple: (traceResult, nextLogIndex, error).
+// The optional state and block overrides are applied before executing the message.
 func (k *Keeper) traceTxWithMsg(
 	ctx sdk.Context,
 	cfg *statedb.EVMConfig,
@@ -765,6 +798,8 @@ func (k *Keeper) traceTxWithMsg(
 	msg *core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
+	stateOverrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -841,7 +876,7 @@ func (k *Keeper) traceTxWithMsg(
 
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
-	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, false, nil)
+	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, false, stateOverrides, blockOverrides)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
@@ -855,6 +890,65 @@ func (k *Keeper) traceTxWithMsg(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// SimulateV1 implements the Query/SimulateV1 gRPC method. It executes the
+// calls of the requested blocks on top of the current state and returns the
+// json encoded simulated blocks. Failures that abort the whole simulation are
+// reported in the response together with their JSON-RPC error code.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty input")
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return &types.SimulateV1Response{
+			Error:     fmt.Sprintf("too many blocks, limit: %d", maxSimulateBlocks),
+			ErrorCode: rpctypes.ErrCodeClientLimitExceeded,
+		}, nil
+	}
+
+	// state changes of the simulation are discarded
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	parent := &ethtypes.Header{
+		Number: big.NewInt(ctx.BlockHeight()),
+		Time:   uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115 // timestamp won't exceed uint64
+	}
+	sim := &simulator{
+		k:        &k,
+		cfg:      cfg,
+		gasCap:   req.GasCap,
+		validate: opts.Validation,
+		traceTx:  opts.TraceTransfers,
+		chainID:  types.GetEthChainConfig().ChainID,
+	}
+	results, err := sim.simulate(ctx, parent, common.HexToHash(req.BlockHash), opts.BlockStateCalls)
+	if err != nil {
+		var simErr *rpctypes.SimError
+		if errors.As(err, &simErr) {
+			return &types.SimulateV1Response{Error: simErr.Message, ErrorCode: int32(simErr.Code)}, nil //nolint:gosec // G115 // error codes fit in int32
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.SimulateV1Response{Data: data}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -12,6 +12,8 @@ import (
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/statedb"
@@ -73,6 +75,10 @@ type Keeper struct {
 	hooks types.EvmHooks
 	// EVM Hooks for tx post-processing
 
+	// stateListener is notified of the state written by the StateDB commits
+	// of the finalized blocks
+	stateListener types.StateCommitListener
+
 	// precompiles defines the map of all available precompiled smart contracts.
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
@@ -229,6 +235,28 @@ func (k *Keeper) HasHooks() bool {
 	return k.hooks != nil
 }
 
+// SetStateCommitListener sets the listener of the state written by the StateDB
+// commits of the finalized blocks.
+// Called only once during initialization, panics if called more than once.
+func (k *Keeper) SetStateCommitListener(listener types.StateCommitListener) *Keeper {
+	if k.stateListener != nil {
+		panic("cannot set evm state commit listener twice")
+	}
+
+	k.stateListener = listener
+	return k
+}
+
+// stateCommitListener returns the state commit listener if the context
+// finalizes a block, the state written by the queries, checks and simulations
+// is not notified.
+func (k *Keeper) stateCommitListener(ctx sdk.Context) types.StateCommitListener {
+	if k.stateListener == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+	return k.stateListener
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -246,6 +274,31 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize))
 }
 
+// EmitSystemLog emits a system log, i.e. an EVM log for a state change made
+// outside of the EVM by the transaction being executed. The log takes the next
+// log index of the block and is added to the block bloom, so that it is
+// returned by eth_getLogs along with the logs of the EVM transactions.
+func (k Keeper) EmitSystemLog(ctx sdk.Context, log *ethtypes.Log) error {
+	log.BlockNumber = uint64(ctx.BlockHeight()) //nolint:gosec // G115 // won't exceed uint64
+	log.BlockHash = common.BytesToHash(ctx.HeaderHash())
+	log.BlockTimestamp = uint64(ctx.BlockTime().Unix()) //nolint:gosec // G115 // won't exceed uint64
+	log.TxHash = common.BytesToHash(cmttypes.Tx(ctx.TxBytes()).Hash())
+	log.Index = uint(k.GetLogSizeTransient(ctx))
+
+	event, err := types.NewSystemLogEvent(log)
+	if err != nil {
+		return err
+	}
+
+	bloom := k.GetBlockBloomTransient(ctx)
+	bloom.Or(bloom, new(big.Int).SetBytes(ethtypes.CreateBloom(&ethtypes.Receipt{Logs: []*ethtypes.Log{log}}).Bytes()))
+	k.SetBlockBloomTransient(ctx, bloom)
+	k.SetLogSizeTransient(ctx, uint64(log.Index)+1)
+
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
@@ -396,6 +449,25 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	return result, nil
 }
 
+// SetFeeGranterTransient sets the fee granter paying the fees of the current
+// cosmos tx, called in ante handler. An empty granter means that the fees are
+// paid by the sender.
+func (k Keeper) SetFeeGranterTransient(ctx sdk.Context, granter sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if granter.Empty() {
+		store.Delete(types.KeyPrefixTransientFeeGranter)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeeGranter, granter)
+}
+
+// GetFeeGranterTransient returns the fee granter paying the fees of the
+// current cosmos tx, if any.
+func (k Keeper) GetFeeGranterTransient(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Get(types.KeyPrefixTransientFeeGranter)
+}
+
 // KVStoreKeys returns KVStore keys injected to keeper
 func (k Keeper) KVStoreKeys() map[string]*storetypes.KVStoreKey {
 	return k.storeKeys
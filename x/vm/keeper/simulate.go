@@ -0,0 +1,508 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request, including the gaps filled in between.
+	maxSimulateBlocks = 256
+
+	// maxSimulateCalls is the maximum number of calls that can be simulated in
+	// a single eth_simulateV1 request, across all its blocks.
+	maxSimulateCalls = 1000
+
+	// simulateTimestampIncrement is the default time difference between two
+	// consecutive simulated blocks.
+	simulateTimestampIncrement = 12
+)
+
+// simulator executes the calls of an eth_simulateV1 request on a branch of the
+// latest state. Changes of a call are visible to the calls (and blocks) that
+// follow it.
+type simulator struct {
+	k        *Keeper
+	cfg      *statedb.EVMConfig
+	gasCap   uint64
+	validate bool
+	traceTx  bool
+	chainID  *big.Int
+
+	// gasRemaining is the gas budget left for the request. Every call draws
+	// from it, so that the gas cap bounds the whole request and not each call.
+	gasRemaining uint64
+}
+
+// simulate runs all the blocks of the request on top of the given parent
+// header and returns the resulting blocks. The hash of the parent is passed
+// separately, as it is the hash of the CometBFT block.
+func (s *simulator) simulate(ctx sdk.Context, parent *ethtypes.Header, parentHash common.Hash, blocks []rpctypes.SimBlock) ([]*rpctypes.SimBlockResult, error) {
+	blocks, err := s.sanitizeChain(parent, blocks)
+	if err != nil {
+		return nil, err
+	}
+	s.gasRemaining = s.gasCap
+	if s.gasCap == 0 {
+		s.gasRemaining = math.MaxUint64
+	}
+
+	results := make([]*rpctypes.SimBlockResult, 0, len(blocks))
+	for _, block := range blocks {
+		result, err := s.processBlock(ctx, parentHash, block)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		parentHash = result.Header.Hash()
+	}
+	return results, nil
+}
+
+// sanitizeChain checks that the block numbers and timestamps of the requested
+// blocks are strictly increasing, assigns the defaults to the ones not set and
+// fills any gap in the block numbers with empty blocks.
+func (s *simulator) sanitizeChain(parent *ethtypes.Header, blocks []rpctypes.SimBlock) ([]rpctypes.SimBlock, error) {
+	res := make([]rpctypes.SimBlock, 0, len(blocks))
+	prevNumber := parent.Number
+	prevTime := parent.Time
+	calls := 0
+	for _, block := range blocks {
+		if calls += len(block.Calls); calls > maxSimulateCalls {
+			return nil, &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeClientLimitExceeded,
+				Message: fmt.Sprintf("too many calls, limit: %d", maxSimulateCalls),
+			}
+		}
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(rpctypes.BlockOverrides)
+		}
+		if block.BlockOverrides.Number == nil {
+			n := new(big.Int).Add(prevNumber, big.NewInt(1))
+			block.BlockOverrides.Number = (*hexutil.Big)(n)
+		}
+		diff := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), prevNumber)
+		if diff.Sign() <= 0 {
+			return nil, &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeBlockNumberInvalid,
+				Message: fmt.Sprintf("block numbers must be in order: %d <= %d", block.BlockOverrides.Number.ToInt().Uint64(), prevNumber),
+			}
+		}
+		if total := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), parent.Number); total.Cmp(big.NewInt(maxSimulateBlocks)) > 0 {
+			return nil, &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeClientLimitExceeded,
+				Message: fmt.Sprintf("too many blocks, limit: %d", maxSimulateBlocks),
+			}
+		}
+		if diff.Cmp(big.NewInt(1)) > 0 {
+			// Fill the gap with empty blocks.
+			gap := new(big.Int).Sub(diff, big.NewInt(1))
+			for i := uint64(0); i < gap.Uint64(); i++ {
+				n := new(big.Int).Add(prevNumber, big.NewInt(int64(i+1))) //nolint:gosec // G115 // bounded by maxSimulateBlocks
+				t := prevTime + simulateTimestampIncrement
+				res = append(res, rpctypes.SimBlock{
+					BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(n), Time: (*hexutil.Uint64)(&t)},
+				})
+				prevTime = t
+			}
+		}
+		prevNumber = block.BlockOverrides.Number.ToInt()
+
+		if block.BlockOverrides.Time == nil {
+			t := prevTime + simulateTimestampIncrement
+			block.BlockOverrides.Time = (*hexutil.Uint64)(&t)
+		} else if uint64(*block.BlockOverrides.Time) <= prevTime {
+			return nil, &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeBlockTimestampInvalid,
+				Message: fmt.Sprintf("block timestamps must be in order: %d <= %d", uint64(*block.BlockOverrides.Time), prevTime),
+			}
+		}
+		prevTime = uint64(*block.BlockOverrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// processBlock applies the overrides of a simulated block and executes its
+// calls in order.
+func (s *simulator) processBlock(ctx sdk.Context, parentHash common.Hash, block rpctypes.SimBlock) (*rpctypes.SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	number := overrides.Number.ToInt()
+	if !number.IsInt64() {
+		return nil, &rpctypes.SimError{Code: rpctypes.ErrCodeBlockNumberInvalid, Message: "block number overflows int64"}
+	}
+	blockTime := time.Unix(int64(*overrides.Time), 0).UTC() //nolint:gosec // G115 // timestamp won't exceed int64
+
+	ctx = s.k.SetConsensusParamsInCtx(ctx)
+	ctx = ctx.
+		WithBlockHeight(number.Int64()).
+		WithBlockTime(blockTime).
+		WithHeaderHash(nil)
+
+	cfg := *s.cfg
+	if overrides.FeeRecipient != nil {
+		cfg.CoinBase = *overrides.FeeRecipient
+	}
+	switch {
+	case overrides.BaseFeePerGas != nil:
+		cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+	case !s.validate && cfg.BaseFee != nil:
+		// without validation, calls may be executed with a zero gas price
+		cfg.BaseFee = new(big.Int)
+	}
+	if !s.validate {
+		cfg.FeeMarketParams.NoBaseFee = true
+	}
+	gasLimit := antetypes.BlockGasLimit(ctx)
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+		if cp := ctx.ConsensusParams(); cp.Block != nil {
+			blockParams := *cp.Block
+			blockParams.MaxGas = int64(gasLimit) //nolint:gosec // G115 // gas limit won't exceed int64
+			cp.Block = &blockParams
+			ctx = ctx.WithConsensusParams(cp)
+		}
+	}
+
+	if block.StateOverrides != nil {
+		if err := s.applyStateOverrides(ctx, block.StateOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		gasUsed  uint64
+		logIndex uint
+		txs      = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders  = make([]common.Address, 0, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, 0, len(block.Calls))
+		calls    = make([]rpctypes.SimCallResult, 0, len(block.Calls))
+	)
+	for i, call := range block.Calls {
+		if err := s.sanitizeCall(ctx, &call, &cfg, gasUsed, gasLimit); err != nil {
+			return nil, err
+		}
+
+		tx := call.ToTransaction(ethtypes.DynamicFeeTxType)
+		txConfig := statedb.NewTxConfig(tx.Hash(), uint(i), logIndex) //nolint:gosec // G115 // won't exceed uint
+		msg := call.ToMessage(cfg.BaseFee, !s.validate, !s.validate)
+
+		var tracer *transferTracer
+		var hooks *tracing.Hooks
+		if s.traceTx {
+			tracer = newTransferTracer()
+			hooks = tracer.Hooks()
+		}
+
+		// the block overrides set the header fields missing from the context, e.g. PREVRANDAO
+		res, err := s.k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, hooks, true, &cfg, txConfig, false, nil, overrides)
+		if err != nil {
+			return nil, simCallFailure(err)
+		}
+		// ApplyMessageWithConfig only manages the nonce of contract creations,
+		// the ante handler increments it for calls.
+		if msg.To != nil {
+			if err := s.incrementNonce(ctx, msg.From, msg.Nonce); err != nil {
+				return nil, err
+			}
+		}
+
+		callRes := rpctypes.SimCallResult{
+			ReturnValue: res.Ret,
+			Logs:        []*ethtypes.Log{},
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		switch {
+		case res.VmError == vm.ErrExecutionReverted.Error():
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			data, _ := revertErr.ErrorData().(string)
+			callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			callRes.Error = &rpctypes.SimCallError{
+				Message: revertErr.Error(),
+				Code:    rpctypes.ErrCodeReverted,
+				Data:    data,
+			}
+		case res.Failed():
+			callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			callRes.Error = &rpctypes.SimCallError{Message: res.VmError, Code: rpctypes.ErrCodeVMError}
+		default:
+			callRes.Logs = types.LogsToEthereum(res.Logs)
+			if tracer != nil {
+				callRes.Logs = tracer.merge(callRes.Logs)
+			}
+		}
+		for j, log := range callRes.Logs {
+			log.BlockNumber = number.Uint64()
+			log.TxHash = tx.Hash()
+			log.TxIndex = uint(i)          //nolint:gosec // G115 // won't exceed uint
+			log.Index = logIndex + uint(j) //nolint:gosec // G115 // won't exceed uint
+		}
+		logIndex += uint(len(callRes.Logs))
+		gasUsed += res.GasUsed
+		s.gasRemaining -= res.GasUsed
+
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            uint64(callRes.Status),
+			CumulativeGasUsed: gasUsed,
+			Logs:              callRes.Logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+			TransactionIndex:  uint(i), //nolint:gosec // G115 // won't exceed uint
+		}
+		if msg.To == nil {
+			receipt.ContractAddress = crypto.CreateAddress(msg.From, msg.Nonce)
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+		txs = append(txs, tx)
+		senders = append(senders, msg.From)
+		receipts = append(receipts, receipt)
+		calls = append(calls, callRes)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   cfg.CoinBase,
+		Difficulty: big.NewInt(0),
+		Number:     number,
+		GasLimit:   gasLimit,
+		GasUsed:    gasUsed,
+		Time:       uint64(*overrides.Time),
+		Extra:      []byte{},
+	}
+	if overrides.PrevRandao != nil {
+		header.MixDigest = *overrides.PrevRandao
+	}
+	ethCfg := types.GetEthChainConfig()
+	if ethCfg.IsLondon(header.Number) {
+		header.BaseFee = cfg.BaseFee
+	}
+	if ethCfg.IsCancun(header.Number, header.Time) {
+		header.ExcessBlobGas = new(uint64)
+		header.BlobGasUsed = new(uint64)
+		header.ParentBeaconRoot = new(common.Hash)
+	}
+	if ethCfg.IsPrague(header.Number, header.Time) {
+		header.RequestsHash = &ethtypes.EmptyRequestsHash
+	}
+
+	ethBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	blockHash := ethBlock.Hash()
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = blockHash
+		}
+	}
+
+	return &rpctypes.SimBlockResult{
+		Header:       ethBlock.Header(),
+		Transactions: txs,
+		Senders:      senders,
+		Calls:        calls,
+	}, nil
+}
+
+// sanitizeCall fills in the defaults of a simulated call, caps its gas by the
+// budget left for the request and, when validation is enabled, checks the
+// sender nonce and balance.
+func (s *simulator) sanitizeCall(ctx sdk.Context, call *types.TransactionArgs, cfg *statedb.EVMConfig, gasUsed, gasLimit uint64) error {
+	from := call.GetFrom()
+	nonce := s.k.GetNonce(ctx, from)
+	if call.Nonce == nil {
+		call.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if s.validate {
+		if have := uint64(*call.Nonce); have < nonce {
+			return &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeNonceTooLow,
+				Message: fmt.Sprintf("%s: address %s, tx: %d state: %d", core.ErrNonceTooLow, from.Hex(), have, nonce),
+			}
+		} else if have > nonce {
+			return &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeNonceTooHigh,
+				Message: fmt.Sprintf("%s: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from.Hex(), have, nonce),
+			}
+		}
+	}
+
+	remaining := gasLimit - gasUsed
+	if call.Gas == nil {
+		call.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if uint64(*call.Gas) > remaining {
+		return &rpctypes.SimError{
+			Code:    rpctypes.ErrCodeBlockGasLimitReached,
+			Message: fmt.Sprintf("block gas limit reached: %d >= %d", gasUsed, gasLimit),
+		}
+	}
+	if s.gasRemaining == 0 {
+		return &rpctypes.SimError{
+			Code:    rpctypes.ErrCodeClientLimitExceeded,
+			Message: fmt.Sprintf("request gas cap exhausted: %d", s.gasCap),
+		}
+	}
+	// the gas of the call is capped by the budget left for the request
+	if err := call.CallDefaults(s.gasRemaining, cfg.BaseFee, s.chainID); err != nil {
+		return &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: err.Error()}
+	}
+
+	if s.validate {
+		msg := call.ToMessage(cfg.BaseFee, false, false)
+		if cfg.BaseFee != nil && msg.GasFeeCap.Cmp(cfg.BaseFee) < 0 {
+			return &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeInvalidParams,
+				Message: fmt.Sprintf("%s: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, from.Hex(), msg.GasFeeCap, cfg.BaseFee),
+			}
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+		cost.Add(cost, msg.Value)
+		if balance := s.k.GetAccountOrEmpty(ctx, from).Balance; balance.ToBig().Cmp(cost) < 0 {
+			return &rpctypes.SimError{
+				Code:    rpctypes.ErrCodeInsufficientFunds,
+				Message: fmt.Sprintf("%s: address %s have %s want %s", core.ErrInsufficientFunds, from.Hex(), balance, cost),
+			}
+		}
+	}
+	return nil
+}
+
+// applyStateOverrides commits the state overrides of a simulated block, so
+// that they are visible to all the calls of the block and the ones after it.
+func (s *simulator) applyStateOverrides(ctx sdk.Context, overrides *rpctypes.StateOverride) error {
+	for _, account := range *overrides {
+		if account.MovePrecompileTo != nil {
+			return &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: "movePrecompileToAddress is not supported"}
+		}
+	}
+	stateDB := statedb.New(ctx, s.k, statedb.NewEmptyTxConfig())
+	if err := overrides.Apply(stateDB, nil); err != nil {
+		return &rpctypes.SimError{Code: rpctypes.ErrCodeInvalidParams, Message: err.Error()}
+	}
+	return stateDB.Commit()
+}
+
+// incrementNonce sets the nonce of the sender of a simulated call.
+func (s *simulator) incrementNonce(ctx sdk.Context, from common.Address, nonce uint64) error {
+	acct := s.k.GetAccountOrEmpty(ctx, from)
+	acct.Nonce = nonce + 1
+	return s.k.SetAccount(ctx, from, acct)
+}
+
+// simCallFailure converts the error of a call that could not be executed into
+// a simulation error.
+func simCallFailure(err error) error {
+	code := rpctypes.ErrCodeInternalError
+	if errors.Is(err, core.ErrIntrinsicGas) || errors.Is(err, core.ErrFloorDataGas) {
+		code = rpctypes.ErrCodeIntrinsicGas
+	}
+	return &rpctypes.SimError{Code: code, Message: err.Error()}
+}
+
+// transferTracer is a tracer that records native value transfers as ERC-7528
+// `Transfer` logs. The logs of reverted call frames are discarded.
+type transferTracer struct {
+	stateDB interface{ Logs() []*ethtypes.Log }
+	// frames holds the transfer logs of each open call frame
+	frames [][]transferLog
+}
+
+// transferLog is a synthetic transfer log together with the number of logs
+// emitted by the EVM before it.
+type transferLog struct {
+	log      *ethtypes.Log
+	position int
+}
+
+func newTransferTracer() *transferTracer {
+	return &transferTracer{}
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *transferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *transferTracer) onTxStart(env *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	if db, ok := env.StateDB.(interface{ Logs() []*ethtypes.Log }); ok {
+		t.stateDB = db
+	}
+	t.frames = [][]transferLog{{}}
+}
+
+func (t *transferTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, []transferLog{})
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 {
+		return
+	}
+	amount, _ := uint256.FromBig(value)
+	data := amount.Bytes32()
+	position := 0
+	if t.stateDB != nil {
+		position = len(t.stateDB.Logs())
+	}
+	last := len(t.frames) - 1
+	t.frames[last] = append(t.frames[last], transferLog{
+		log: &ethtypes.Log{
+			Address: rpctypes.TransferLogAddress,
+			Topics:  []common.Hash{rpctypes.TransferLogTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    data[:],
+		},
+		position: position,
+	})
+}
+
+func (t *transferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	last := len(t.frames) - 1
+	frame := t.frames[last]
+	t.frames = t.frames[:last]
+	if !reverted {
+		t.frames[last-1] = append(t.frames[last-1], frame...)
+	}
+}
+
+// merge inserts the recorded transfer logs among the logs emitted by the EVM.
+func (t *transferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.frames) == 0 || len(t.frames[0]) == 0 {
+		return logs
+	}
+	transfers := t.frames[0]
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(transfers))
+	j := 0
+	for i := 0; i <= len(logs); i++ {
+		for j < len(transfers) && transfers[j].position <= i {
+			merged = append(merged, transfers[j].log)
+			j++
+		}
+		if i < len(logs) {
+			merged = append(merged, logs[i])
+		}
+	}
+	return merged
+}
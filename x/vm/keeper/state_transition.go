@@ -33,6 +33,7 @@ import (
 // for call hooks; otherwise, it will use the recipient-specific precompile hook.
 // This is useful for scenarios such as eth_call, state overrides, or testing where custom precompile logic is needed.
 // The function sets up the block context, transaction context, and VM configuration before returning the EVM instance.
+// The optional block overrides are applied to the block context, e.g. for eth_call and debug_traceCall.
 func (k *Keeper) NewEVMWithOverridePrecompiles(
 	ctx sdk.Context,
 	msg core.Message,
@@ -40,6 +41,7 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 	tracer *tracing.Hooks,
 	stateDB vm.StateDB,
 	overridePrecompiles bool,
+	blockOverrides *rpctypes.BlockOverrides,
 ) *vm.EVM {
 	ctx = k.SetConsensusParamsInCtx(ctx)
 	blockCtx := vm.BlockContext{
@@ -54,6 +56,7 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 		BaseFee:     cfg.BaseFee,
 		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
+	blockOverrides.Apply(&blockCtx)
 
 	ethCfg := types.GetEthChainConfig()
 	txCtx := core.NewEVMTxContext(&msg)
@@ -107,6 +110,7 @@ func (k *Keeper) NewEVM(
 		tracer,
 		stateDB,
 		true,
+		nil,
 	)
 }
 
@@ -214,7 +218,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	tmpCtx, commitFn := ctx.CacheContext()
 
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil, nil)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -341,7 +345,7 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing
 	}
 
 	txConfig := statedb.NewEmptyTxConfig()
-	return k.ApplyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig, internal, nil)
+	return k.ApplyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig, internal, nil, nil)
 }
 
 // ApplyMessageWithConfig computes the new state by applying the given message against the existing state.
@@ -382,6 +386,11 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing
 // # Commit parameter
 //
 // If commit is true, the `StateDB` will be committed, otherwise discarded.
+//
+// # Overrides parameters
+//
+// The optional state overrides are applied to the `StateDB` and the optional
+// block overrides to the EVM block context before executing the message.
 func (k *Keeper) ApplyMessageWithConfig(
 	ctx sdk.Context,
 	msg core.Message,
@@ -391,15 +400,17 @@ func (k *Keeper) ApplyMessageWithConfig(
 	txConfig statedb.TxConfig,
 	internal bool,
 	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	// the bank movements of the execution are traced by the EVM itself
+	stateDB := statedb.New(types.WithEVMExecution(ctx), k, txConfig)
 	ethCfg := types.GetEthChainConfig()
-	evm := k.NewEVMWithOverridePrecompiles(ctx, msg, cfg, tracer, stateDB, overrides == nil)
+	evm := k.NewEVMWithOverridePrecompiles(ctx, msg, cfg, tracer, stateDB, overrides == nil, blockOverrides)
 	// Gas limit suffices for the floor data cost (EIP-7623)
 	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
 	if overrides != nil {
@@ -157,6 +157,9 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 	if err := k.SetBalance(ctx, addr, account.Balance); err != nil {
 		return err
 	}
+	if listener := k.stateCommitListener(ctx); listener != nil {
+		listener.OnAccountCommit(addr)
+	}
 
 	k.Logger(ctx).Debug(
 		"account updated",
@@ -172,6 +175,9 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	store.Set(key.Bytes(), value)
+	if listener := k.stateCommitListener(ctx); listener != nil {
+		listener.OnStorageCommit(addr, key)
+	}
 
 	k.Logger(ctx).Debug(
 		"state updated",
@@ -185,6 +191,9 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	store.Delete(key.Bytes())
+	if listener := k.stateCommitListener(ctx); listener != nil {
+		listener.OnStorageCommit(addr, key)
+	}
 
 	k.Logger(ctx).Debug(
 		"state deleted",
@@ -284,6 +293,9 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
+	if listener := k.stateCommitListener(ctx); listener != nil {
+		listener.OnAccountDelete(addr)
+	}
 
 	k.Logger(ctx).Debug(
 		"account suicided",
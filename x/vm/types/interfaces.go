@@ -79,6 +79,26 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// StateCommitListener is notified of the accounts and storage slots written by
+// the StateDB commits of the finalized blocks.
+type StateCommitListener interface {
+	// OnAccountCommit is called when the nonce, balance or code of an account is written.
+	OnAccountCommit(addr common.Address)
+	// OnStorageCommit is called when a storage slot of an account is written or deleted.
+	OnStorageCommit(addr common.Address, key common.Hash)
+	// OnAccountDelete is called when a self-destructed account is deleted along with its storage.
+	OnAccountDelete(addr common.Address)
+}
+
+// FeeDenomOracle values the fees of the Cosmos transactions paid in other
+// denoms than the EVM denom, e.g. the stablecoins accepted by a fee abstraction
+// module, when enforcing the minimum gas price and prioritizing transactions.
+type FeeDenomOracle interface {
+	// ConvertToEVMDenom returns the value of the coin in the EVM denom, returns
+	// false if the denom is not accepted for fees.
+	ConvertToEVMDenom(ctx sdk.Context, coin sdk.Coin) (math.Int, bool)
+}
+
 // BankWrapper defines the methods required by the wrapper around
 // the Cosmos SDK x/bank keeper that is used to manage an EVM coin
 // with a configurable value for decimals.
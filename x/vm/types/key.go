@@ -36,6 +36,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeeGranter
 )
 
 // KVStore key prefixes
@@ -49,10 +50,11 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom      = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex    = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize    = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed    = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeeGranter = []byte{prefixTransientFeeGranter}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -883,6 +883,8 @@ type EthCallRequest struct {
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// state overrides encoded as json
 	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block overrides encoded as json
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -953,6 +955,13 @@ func (m *EthCallRequest) GetOverrides() []byte {
 	return nil
 }
 
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1356,6 +1365,10 @@ type QueryTraceCallRequest struct {
 	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
 	// chain_id is the the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// state overrides encoded as json
+	Overrides []byte `protobuf:"bytes,9,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block overrides encoded as json
+	BlockOverrides []byte `protobuf:"bytes,10,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
@@ -1447,6 +1460,20 @@ func (m *QueryTraceCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // QueryTraceCallResponse defines TraceCall response
 type QueryTraceCallResponse struct {
 	// data is the response serialized in bytes
@@ -1647,6 +1674,144 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 
+// SimulateV1Request defines the request type for simulating a sequence of
+// blocks with calls on top of the queried state.
+type SimulateV1Request struct {
+	// opts uses the same json format as the json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// block_hash (hex) of the block the simulation is built on
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// SimulateV1Response defines the response type for the SimulateV1 query.
+type SimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// error is the reason the simulation was aborted, if any
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// error_code is the json rpc error code of the abort reason
+	ErrorCode int32 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SimulateV1Response) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SimulateV1Response) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
@@ -1678,120 +1843,128 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "cosmos.evm.vm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "cosmos.evm.vm.v1.SimulateV1Response")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0x76, 0xa2, 0x4c, 0xec, 0x8d, 0xcc, 0xb5, 0x25, 0x87, 0xf1,
-	0x77, 0x1c, 0x32, 0xf6, 0x66, 0x17, 0xd8, 0xec, 0x61, 0xd7, 0x36, 0x1c, 0x27, 0x9b, 0x64, 0x91,
-	0x55, 0x8d, 0x1e, 0x0a, 0x14, 0xc2, 0x88, 0x9a, 0x50, 0x84, 0x45, 0x52, 0xe1, 0x50, 0xaa, 0x9c,
-	0x8f, 0x1e, 0x8a, 0x36, 0x4d, 0x90, 0x4b, 0x8a, 0xde, 0xdb, 0x1c, 0x7b, 0x6b, 0x6f, 0xfd, 0x17,
-	0x72, 0x0c, 0x50, 0x14, 0x28, 0x7a, 0x48, 0x8b, 0xa4, 0x40, 0xfb, 0x37, 0xf4, 0x54, 0xcc, 0x07,
-	0x25, 0x52, 0x14, 0x25, 0xa7, 0x48, 0xd1, 0x1e, 0x0a, 0x18, 0x09, 0x67, 0xe6, 0xcd, 0x7b, 0xbf,
-	0x79, 0xdf, 0x4f, 0x60, 0x56, 0x77, 0x88, 0xe5, 0x10, 0x0d, 0xb7, 0x2c, 0x8d, 0xfe, 0x6d, 0x68,
-	0xb7, 0x9a, 0xd8, 0x3d, 0x54, 0x1b, 0xae, 0xe3, 0x39, 0x30, 0xc7, 0x4f, 0x55, 0xdc, 0xb2, 0x54,
-	0xfa, 0xb7, 0x21, 0x9f, 0x40, 0x96, 0x69, 0x3b, 0x1a, 0xfb, 0x97, 0x13, 0xc9, 0x6b, 0x82, 0x45,
-	0x05, 0x11, 0xcc, 0x6f, 0x6b, 0xad, 0x8d, 0x0a, 0xf6, 0xd0, 0x86, 0xd6, 0x40, 0x86, 0x69, 0x23,
-	0xcf, 0x74, 0x6c, 0x41, 0x2b, 0x47, 0xc4, 0x51, 0xd6, 0xfc, 0x6c, 0x26, 0x72, 0xe6, 0xb5, 0xc5,
-	0xd1, 0x94, 0xe1, 0x18, 0x0e, 0xfb, 0xd4, 0xe8, 0x97, 0xd8, 0x9d, 0x35, 0x1c, 0xc7, 0xa8, 0x63,
-	0x0d, 0x35, 0x4c, 0x0d, 0xd9, 0xb6, 0xe3, 0x31, 0x49, 0x44, 0x9c, 0x16, 0xc5, 0x29, 0x5b, 0x55,
-	0x9a, 0x37, 0x35, 0xcf, 0xb4, 0x30, 0xf1, 0x90, 0xd5, 0xe0, 0x04, 0xca, 0x14, 0x80, 0xff, 0xa7,
-	0x68, 0x77, 0x1c, 0xfb, 0xa6, 0x69, 0x94, 0xf0, 0xad, 0x26, 0x26, 0x9e, 0x72, 0x0d, 0x9c, 0x0c,
-	0xed, 0x92, 0x86, 0x63, 0x13, 0x0c, 0xff, 0x0e, 0xc6, 0x75, 0xb6, 0x93, 0x97, 0xe6, 0xa5, 0x95,
-	0xec, 0xe6, 0x9c, 0xda, 0xab, 0x1a, 0x75, 0xa7, 0x86, 0x4c, 0x5b, 0x5c, 0x13, 0xc4, 0xca, 0x3f,
-	0x05, 0xb7, 0x2d, 0x5d, 0x77, 0x9a, 0xb6, 0x27, 0x84, 0xc0, 0x3c, 0x48, 0xa1, 0x6a, 0xd5, 0xc5,
-	0x84, 0x30, 0x76, 0x99, 0x92, 0xbf, 0xbc, 0x98, 0x7e, 0xf0, 0xa4, 0x38, 0xf2, 0xd3, 0x93, 0xe2,
-	0x88, 0xa2, 0x83, 0xa9, 0xf0, 0x55, 0x81, 0x24, 0x0f, 0x52, 0x15, 0x54, 0x47, 0xb6, 0x8e, 0xfd,
-	0xbb, 0x62, 0x09, 0xff, 0x0a, 0x32, 0xba, 0x53, 0xc5, 0xe5, 0x1a, 0x22, 0xb5, 0xfc, 0x28, 0x3b,
-	0x4b, 0xd3, 0x8d, 0xcb, 0x88, 0xd4, 0xe0, 0x14, 0x18, 0xb3, 0x1d, 0x7a, 0x29, 0x31, 0x2f, 0xad,
-	0x24, 0x4b, 0x7c, 0xa1, 0xfc, 0x1b, 0xcc, 0x88, 0xd7, 0xd2, 0xc7, 0xfc, 0x0a, 0x94, 0xf7, 0x25,
-	0x20, 0xf7, 0xe3, 0x20, 0xc0, 0x2e, 0x82, 0x63, 0x5c, 0x4f, 0xe5, 0x30, 0xa7, 0x49, 0xbe, 0xbb,
-	0xc5, 0x37, 0xa1, 0x0c, 0xd2, 0x84, 0x0a, 0xa5, 0xf8, 0x46, 0x19, 0xbe, 0xce, 0x9a, 0xb2, 0x40,
-	0x9c, 0x6b, 0xd9, 0x6e, 0x5a, 0x15, 0xec, 0x8a, 0x17, 0x4c, 0x8a, 0xdd, 0xff, 0xb1, 0x4d, 0xe5,
-	0x2a, 0x98, 0x65, 0x38, 0xde, 0x44, 0x75, 0xb3, 0x8a, 0x3c, 0xc7, 0xed, 0x79, 0xcc, 0x69, 0x30,
-	0xa1, 0x3b, 0x76, 0x2f, 0x8e, 0x2c, 0xdd, 0xdb, 0x8a, 0xbc, 0xea, 0x91, 0x04, 0xe6, 0x62, 0xb8,
-	0x89, 0x87, 0x2d, 0x83, 0xe3, 0x3e, 0xaa, 0x30, 0x47, 0x1f, 0xec, 0x6b, 0x7c, 0x9a, 0xef, 0x44,
-	0xdb, 0xdc, 0xce, 0xaf, 0x62, 0x9e, 0xf3, 0xc2, 0x89, 0x3a, 0x57, 0x87, 0x39, 0x91, 0x72, 0x55,
-	0x08, 0x7b, 0xc3, 0x73, 0x5c, 0x64, 0x0c, 0x17, 0x06, 0x73, 0x20, 0x71, 0x80, 0x0f, 0x85, 0xbf,
-	0xd1, 0xcf, 0x80, 0xf8, 0x75, 0x21, 0xbe, 0xc3, 0x4c, 0x88, 0x9f, 0x02, 0x63, 0x2d, 0x54, 0x6f,
-	0xfa, 0xc2, 0xf9, 0x42, 0xf9, 0x07, 0xc8, 0x09, 0x57, 0xaa, 0xbe, 0xd2, 0x23, 0x97, 0xc1, 0x89,
-	0xc0, 0x3d, 0x21, 0x02, 0x82, 0x24, 0xf5, 0x7d, 0x76, 0x6b, 0xa2, 0xc4, 0xbe, 0x95, 0xdb, 0x22,
-	0xe2, 0xf7, 0xdb, 0xd7, 0x1c, 0x83, 0xf8, 0x22, 0x20, 0x48, 0xb2, 0x88, 0xe1, 0xfc, 0xd9, 0x37,
-	0xbc, 0x04, 0x40, 0x37, 0x77, 0xb1, 0xb7, 0x65, 0x37, 0x97, 0xfc, 0x90, 0xa7, 0x89, 0x4e, 0xe5,
-	0x69, 0x52, 0x24, 0x3a, 0xf5, 0x46, 0x57, 0x55, 0xa5, 0xc0, 0xcd, 0x00, 0xc8, 0x87, 0x92, 0x50,
-	0xac, 0x2f, 0x5c, 0xe0, 0x5c, 0x05, 0xc9, 0xba, 0x63, 0xd0, 0xd7, 0x25, 0x56, 0xb2, 0x9b, 0xd3,
-	0xd1, 0xb4, 0x72, 0xcd, 0x31, 0x4a, 0x8c, 0x04, 0xee, 0xf5, 0x01, 0xb5, 0x3c, 0x14, 0x14, 0x97,
-	0x13, 0x44, 0xd5, 0xc9, 0x7c, 0x37, 0x90, 0x8b, 0x2c, 0x5f, 0x0f, 0x4a, 0x49, 0x00, 0xf4, 0x77,
-	0x05, 0xc0, 0x7f, 0x81, 0xf1, 0x06, 0xdb, 0x11, 0x99, 0x2f, 0x1f, 0x85, 0xc8, 0x6f, 0x6c, 0x67,
-	0x9e, 0x3e, 0x2f, 0x8e, 0x7c, 0xf6, 0xe3, 0x17, 0x6b, 0x52, 0x49, 0x5c, 0x51, 0xbe, 0x96, 0xc0,
-	0xb1, 0x5d, 0xaf, 0xb6, 0x83, 0xea, 0xf5, 0x80, 0xba, 0x91, 0x6b, 0x10, 0xdf, 0x30, 0xf4, 0x1b,
-	0x9e, 0x02, 0x29, 0x03, 0x91, 0xb2, 0x8e, 0x1a, 0x22, 0x46, 0xc6, 0x0d, 0x44, 0x76, 0x50, 0x03,
-	0xbe, 0x0d, 0x72, 0x0d, 0xd7, 0x69, 0x38, 0x04, 0xbb, 0x9d, 0x38, 0xa3, 0x31, 0x32, 0xb1, 0xbd,
-	0xf9, 0xf3, 0xf3, 0xa2, 0x6a, 0x98, 0x5e, 0xad, 0x59, 0x51, 0x75, 0xc7, 0xd2, 0x44, 0xf1, 0xe0,
-	0xff, 0x9d, 0x23, 0xd5, 0x03, 0xcd, 0x3b, 0x6c, 0x60, 0xa2, 0xee, 0x74, 0x03, 0xbc, 0x74, 0xdc,
-	0xe7, 0xe5, 0x07, 0xe7, 0x0c, 0x48, 0xeb, 0x34, 0x6b, 0x97, 0xcd, 0x6a, 0x3e, 0x39, 0x2f, 0xad,
-	0x24, 0x4a, 0x29, 0xb6, 0xbe, 0x52, 0x85, 0xb3, 0x20, 0xe3, 0xb4, 0xb0, 0xeb, 0x9a, 0x55, 0x4c,
-	0xf2, 0x63, 0x0c, 0x6b, 0x77, 0x43, 0xd9, 0x07, 0x27, 0x77, 0x89, 0x67, 0x5a, 0xc8, 0xc3, 0x7b,
-	0xa8, 0xab, 0xab, 0x1c, 0x48, 0x18, 0x88, 0x3f, 0x2d, 0x59, 0xa2, 0x9f, 0x74, 0xc7, 0xc5, 0x1e,
-	0x7b, 0xd5, 0x44, 0x89, 0x7e, 0x52, 0x99, 0x2d, 0xab, 0x8c, 0x5d, 0xd7, 0xe1, 0xe1, 0x9e, 0x29,
-	0xa5, 0x5a, 0xd6, 0x2e, 0x5d, 0x2a, 0x0f, 0x93, 0xbe, 0x8f, 0xb8, 0x48, 0xc7, 0xfb, 0x6d, 0x5f,
-	0x65, 0x1b, 0x20, 0x61, 0x11, 0xbf, 0xf2, 0x14, 0xa3, 0xfa, 0xbf, 0x4e, 0x8c, 0x5d, 0xaf, 0x86,
-	0x5d, 0xdc, 0xb4, 0xf6, 0xdb, 0x25, 0x4a, 0x0b, 0xff, 0x03, 0x26, 0x3c, 0xca, 0xa4, 0x2c, 0xaa,
-	0x56, 0x22, 0xae, 0x6a, 0x31, 0x51, 0xa2, 0x6a, 0x65, 0xbd, 0xee, 0x02, 0xee, 0x80, 0x89, 0x86,
-	0x8b, 0xab, 0x58, 0xc7, 0x84, 0x38, 0x2e, 0xc9, 0x27, 0x99, 0x83, 0x0e, 0x95, 0x1e, 0xba, 0x44,
-	0xb3, 0x6e, 0xa5, 0xee, 0xe8, 0x07, 0x7e, 0x7e, 0x1b, 0x63, 0x4a, 0xce, 0xb2, 0x3d, 0x9e, 0xdd,
-	0xe0, 0x1c, 0x00, 0x9c, 0x84, 0x05, 0xe1, 0x38, 0xd3, 0x48, 0x86, 0xed, 0xb0, 0xba, 0x75, 0xd9,
-	0x3f, 0xa6, 0xe5, 0x3b, 0x9f, 0x62, 0xcf, 0x90, 0x55, 0x5e, 0xdb, 0x55, 0xbf, 0xb6, 0xab, 0xfb,
-	0x7e, 0x6d, 0xdf, 0x9e, 0xa4, 0x4e, 0xf8, 0xf8, 0xbb, 0xa2, 0xc4, 0x1d, 0x91, 0x73, 0xa2, 0xc7,
-	0x7d, 0x7d, 0x29, 0xfd, 0xdb, 0xf8, 0x52, 0x26, 0xec, 0x4b, 0x0a, 0x98, 0xe4, 0x6f, 0xb0, 0x50,
-	0xbb, 0x4c, 0x1d, 0x04, 0x04, 0xd4, 0x70, 0x1d, 0xb5, 0xf7, 0x10, 0xf9, 0x6f, 0x32, 0x3d, 0x9a,
-	0x4b, 0x94, 0xd2, 0x5e, 0xbb, 0x6c, 0xda, 0x55, 0xdc, 0x56, 0xd6, 0x44, 0xea, 0xec, 0xb8, 0x42,
-	0x37, 0xaf, 0x55, 0x91, 0x87, 0xfc, 0xf0, 0xa1, 0xdf, 0xca, 0x97, 0x09, 0xf0, 0x97, 0x2e, 0xf1,
-	0x36, 0xe5, 0x1a, 0x70, 0x1d, 0xaf, 0xed, 0x67, 0x97, 0xe1, 0xae, 0xe3, 0xb5, 0xc9, 0x6b, 0x70,
-	0x9d, 0x3f, 0xad, 0x7e, 0x44, 0xab, 0x2b, 0xe7, 0xc0, 0xa9, 0x88, 0xe1, 0x06, 0x18, 0xfa, 0xc3,
-	0x04, 0x98, 0xee, 0xd2, 0xff, 0x51, 0xb3, 0x6a, 0xaf, 0x03, 0x25, 0x7f, 0x07, 0x07, 0xda, 0x79,
-	0x45, 0x07, 0x4a, 0xfb, 0x0e, 0x14, 0xf4, 0x9d, 0xa0, 0x71, 0xd3, 0x21, 0xe3, 0x2a, 0xeb, 0xc1,
-	0x88, 0xe3, 0x86, 0x18, 0x60, 0xb7, 0xe9, 0x4e, 0x07, 0x47, 0xf0, 0x25, 0x8c, 0xbb, 0xb3, 0xc6,
-	0x54, 0x78, 0x5b, 0xb0, 0xb8, 0x00, 0xd2, 0xb4, 0x9c, 0x97, 0x6f, 0x62, 0xd1, 0x21, 0x6d, 0xcf,
-	0x7c, 0xfb, 0xbc, 0x38, 0xcd, 0xf5, 0x47, 0xaa, 0x07, 0xaa, 0xe9, 0x68, 0x16, 0xf2, 0x6a, 0xea,
-	0x15, 0xdb, 0xa3, 0x9d, 0x1b, 0xbb, 0xad, 0x14, 0x45, 0xcf, 0xba, 0x57, 0x77, 0x2a, 0xa8, 0x7e,
-	0xdd, 0xb4, 0xf7, 0x10, 0xb9, 0xe1, 0x9a, 0x9d, 0x86, 0x51, 0xd1, 0x41, 0x21, 0x8e, 0x40, 0x08,
-	0xde, 0x02, 0x93, 0x96, 0x69, 0x53, 0x67, 0x2d, 0x37, 0xe8, 0x81, 0x90, 0x3e, 0x47, 0x95, 0x13,
-	0x8f, 0x20, 0x6b, 0x75, 0x59, 0x6d, 0x7e, 0x94, 0x03, 0x63, 0x4c, 0x0a, 0xfc, 0x40, 0x02, 0x29,
-	0xd1, 0x36, 0xc3, 0xc5, 0xa8, 0xf1, 0xfb, 0xcc, 0x45, 0xf2, 0xd2, 0x30, 0x32, 0x8e, 0x53, 0x39,
-	0xfb, 0xde, 0x57, 0x3f, 0x7c, 0x3c, 0xba, 0x08, 0xcf, 0x68, 0x91, 0x99, 0x51, 0xb4, 0xce, 0xda,
-	0x1d, 0xe1, 0xd8, 0xf7, 0xe0, 0x27, 0x12, 0x98, 0x0c, 0x4d, 0x27, 0xf0, 0x6c, 0x8c, 0x98, 0x7e,
-	0x53, 0x90, 0xbc, 0x7e, 0x34, 0x62, 0x81, 0x6c, 0x93, 0x21, 0x5b, 0x87, 0x6b, 0x51, 0x64, 0xfe,
-	0x20, 0x14, 0x01, 0xf8, 0xb9, 0x04, 0x72, 0xbd, 0x83, 0x06, 0x54, 0x63, 0xc4, 0xc6, 0xcc, 0x37,
-	0xb2, 0x76, 0x64, 0x7a, 0x81, 0xf4, 0x22, 0x43, 0x7a, 0x01, 0x6e, 0x46, 0x91, 0xb6, 0xfc, 0x3b,
-	0x5d, 0xb0, 0xc1, 0xd9, 0xe9, 0x1e, 0xbc, 0x2f, 0x81, 0x94, 0x18, 0x29, 0x62, 0x4d, 0x1b, 0x9e,
-	0x56, 0x62, 0x4d, 0xdb, 0x33, 0x99, 0x28, 0xeb, 0x0c, 0xd6, 0x12, 0x5c, 0x88, 0xc2, 0x12, 0x23,
-	0x0a, 0x09, 0xa8, 0xee, 0x91, 0x04, 0x52, 0x62, 0xb8, 0x88, 0x05, 0x12, 0x9e, 0x64, 0x62, 0x81,
-	0xf4, 0xcc, 0x28, 0xca, 0x06, 0x03, 0x72, 0x16, 0xae, 0x46, 0x81, 0x10, 0x4e, 0xda, 0xc5, 0xa1,
-	0xdd, 0x39, 0xc0, 0x87, 0xf7, 0xe0, 0x6d, 0x90, 0xa4, 0x33, 0x08, 0x54, 0x62, 0x5d, 0xa6, 0x33,
-	0xd8, 0xc8, 0x67, 0x06, 0xd2, 0x08, 0x0c, 0xab, 0x0c, 0xc3, 0x19, 0x78, 0xba, 0x9f, 0x37, 0x55,
-	0x43, 0x9a, 0x78, 0x07, 0x8c, 0xf3, 0x36, 0x1c, 0x2e, 0xc4, 0x70, 0x0e, 0x75, 0xfb, 0xf2, 0xe2,
-	0x10, 0x2a, 0x81, 0x60, 0x9e, 0x21, 0x90, 0x61, 0x3e, 0x8a, 0x80, 0xb7, 0xf8, 0xb0, 0x0d, 0x52,
-	0xa2, 0xc3, 0x87, 0xf3, 0x51, 0x9e, 0xe1, 0xe6, 0x5f, 0x5e, 0x1e, 0xd6, 0x81, 0xf8, 0x72, 0x15,
-	0x26, 0x77, 0x16, 0xca, 0x51, 0xb9, 0xd8, 0xab, 0x95, 0x75, 0x2a, 0xee, 0x5d, 0x90, 0x0d, 0x34,
-	0xe1, 0x47, 0x90, 0xde, 0xe7, 0xcd, 0x7d, 0xba, 0x78, 0x65, 0x89, 0xc9, 0x9e, 0x87, 0x85, 0x3e,
-	0xb2, 0x05, 0x39, 0x4d, 0x91, 0xf0, 0x2e, 0x48, 0x89, 0xee, 0x2c, 0xd6, 0xf7, 0xc2, 0x8d, 0x7c,
-	0xac, 0xef, 0xf5, 0x34, 0x79, 0x83, 0x5e, 0xcf, 0x2b, 0xab, 0xd7, 0x86, 0x0f, 0x24, 0x00, 0xba,
-	0x6d, 0x03, 0x5c, 0x19, 0xc4, 0x3a, 0xd8, 0x12, 0xca, 0xab, 0x47, 0xa0, 0x14, 0x38, 0x16, 0x19,
-	0x8e, 0x22, 0x9c, 0x8b, 0xc3, 0xc1, 0x6a, 0x25, 0x7c, 0x5f, 0x02, 0x99, 0x4e, 0x21, 0x84, 0xcb,
-	0x83, 0xf8, 0x07, 0xcd, 0xb1, 0x32, 0x9c, 0x50, 0xe0, 0x58, 0x60, 0x38, 0x0a, 0x70, 0x36, 0x0e,
-	0x07, 0xf3, 0x87, 0xbb, 0x34, 0x29, 0xb1, 0x5a, 0x38, 0x20, 0x29, 0x05, 0x0b, 0xf0, 0x80, 0xa4,
-	0x14, 0x2a, 0xc8, 0x83, 0xec, 0xe1, 0x17, 0x6a, 0x1a, 0x80, 0xa2, 0x7b, 0x59, 0x88, 0x0d, 0xed,
-	0xc0, 0x0f, 0x8d, 0xb1, 0x01, 0x18, 0xfe, 0xe1, 0x71, 0x50, 0x00, 0xf2, 0xf6, 0x0a, 0x7e, 0x2a,
-	0x81, 0x13, 0x91, 0x92, 0x0e, 0xe3, 0xea, 0x41, 0x5c, 0x77, 0x20, 0x9f, 0x3f, 0xfa, 0x05, 0x01,
-	0x6d, 0x99, 0x41, 0x3b, 0x0d, 0x8b, 0x51, 0x68, 0xa1, 0x2e, 0x62, 0xfb, 0xe2, 0xd3, 0x17, 0x05,
-	0xe9, 0xd9, 0x8b, 0x82, 0xf4, 0xfd, 0x8b, 0x82, 0xf4, 0xf8, 0x65, 0x61, 0xe4, 0xd9, 0xcb, 0xc2,
-	0xc8, 0x37, 0x2f, 0x0b, 0x23, 0x6f, 0xcd, 0x47, 0x7b, 0x4d, 0xca, 0xa4, 0x4d, 0xd9, 0xb0, 0x4e,
-	0xb3, 0x32, 0xce, 0x9a, 0xb5, 0xbf, 0xfd, 0x12, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xe7, 0xce, 0x3f,
-	0xa9, 0x16, 0x00, 0x00,
+	// 1821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0x94, 0x48, 0x3e, 0x49, 0x8e, 0x34, 0x96, 0x13, 0x7a, 0x2b, 0x91, 0xf2, 0x5a,
+	0x5f, 0xb6, 0x15, 0x6e, 0xa4, 0xa6, 0x05, 0xea, 0x1e, 0x5a, 0x4b, 0x70, 0x94, 0x34, 0x76, 0xeb,
+	0x6e, 0x84, 0x1c, 0x0a, 0x04, 0xc4, 0x70, 0x39, 0x5e, 0x2e, 0xc4, 0xdd, 0x61, 0x76, 0x96, 0x2c,
+	0x9d, 0xc4, 0x39, 0x14, 0x6d, 0x90, 0x20, 0x97, 0x14, 0xbd, 0xb7, 0x39, 0xf6, 0xd6, 0xde, 0x7a,
+	0xeb, 0x39, 0xa7, 0x22, 0x40, 0x2f, 0x45, 0x0f, 0x6e, 0x61, 0x17, 0x68, 0xff, 0x86, 0x1e, 0x8a,
+	0x62, 0x3e, 0x96, 0xbb, 0xab, 0xe5, 0x6a, 0xe5, 0x22, 0x85, 0x7b, 0x28, 0x40, 0xd8, 0xb3, 0x6f,
+	0xde, 0xbc, 0xf7, 0x9b, 0x79, 0x6f, 0xde, 0xfc, 0x9e, 0x60, 0xd5, 0xa6, 0xcc, 0xa3, 0xcc, 0x24,
+	0x23, 0xcf, 0xe4, 0xbf, 0x3d, 0xf3, 0xdd, 0x21, 0x09, 0x1e, 0xb6, 0x06, 0x01, 0x0d, 0x29, 0x5a,
+	0x92, 0xb3, 0x2d, 0x32, 0xf2, 0x5a, 0xfc, 0xb7, 0xa7, 0x2f, 0x63, 0xcf, 0xf5, 0xa9, 0x29, 0xfe,
+	0x95, 0x4a, 0xfa, 0x0d, 0x65, 0xa2, 0x83, 0x19, 0x91, 0xab, 0xcd, 0xd1, 0x5e, 0x87, 0x84, 0x78,
+	0xcf, 0x1c, 0x60, 0xc7, 0xf5, 0x71, 0xe8, 0x52, 0x5f, 0xe9, 0xea, 0x19, 0x77, 0xdc, 0xb4, 0x9c,
+	0xbb, 0x92, 0x99, 0x0b, 0xc7, 0x6a, 0x6a, 0xc5, 0xa1, 0x0e, 0x15, 0x43, 0x93, 0x8f, 0x94, 0x74,
+	0xd5, 0xa1, 0xd4, 0xe9, 0x13, 0x13, 0x0f, 0x5c, 0x13, 0xfb, 0x3e, 0x0d, 0x85, 0x27, 0xa6, 0x66,
+	0x9b, 0x6a, 0x56, 0x7c, 0x75, 0x86, 0x0f, 0xcc, 0xd0, 0xf5, 0x08, 0x0b, 0xb1, 0x37, 0x90, 0x0a,
+	0xc6, 0x0a, 0xa0, 0x1f, 0x72, 0xb4, 0x87, 0xd4, 0x7f, 0xe0, 0x3a, 0x16, 0x79, 0x77, 0x48, 0x58,
+	0x68, 0xdc, 0x85, 0x4b, 0x29, 0x29, 0x1b, 0x50, 0x9f, 0x11, 0xf4, 0x0d, 0x98, 0xb3, 0x85, 0xa4,
+	0xae, 0xad, 0x6b, 0x3b, 0xf3, 0xfb, 0x6b, 0xad, 0xd3, 0x47, 0xd3, 0x3a, 0xec, 0x61, 0xd7, 0x57,
+	0xcb, 0x94, 0xb2, 0xf1, 0x2d, 0x65, 0xed, 0xb6, 0x6d, 0xd3, 0xa1, 0x1f, 0x2a, 0x27, 0xa8, 0x0e,
+	0x15, 0xdc, 0xed, 0x06, 0x84, 0x31, 0x61, 0xae, 0x66, 0x45, 0x9f, 0xb7, 0xaa, 0x1f, 0x7f, 0xde,
+	0x9c, 0xf9, 0xc7, 0xe7, 0xcd, 0x19, 0xc3, 0x86, 0x95, 0xf4, 0x52, 0x85, 0xa4, 0x0e, 0x95, 0x0e,
+	0xee, 0x63, 0xdf, 0x26, 0xd1, 0x5a, 0xf5, 0x89, 0xbe, 0x06, 0x35, 0x9b, 0x76, 0x49, 0xbb, 0x87,
+	0x59, 0xaf, 0x7e, 0x41, 0xcc, 0x55, 0xb9, 0xe0, 0x75, 0xcc, 0x7a, 0x68, 0x05, 0x66, 0x7d, 0xca,
+	0x17, 0x95, 0xd6, 0xb5, 0x9d, 0xb2, 0x25, 0x3f, 0x8c, 0xef, 0xc0, 0x15, 0xb5, 0x5b, 0xbe, 0x99,
+	0xff, 0x00, 0xe5, 0x47, 0x1a, 0xe8, 0xd3, 0x2c, 0x28, 0xb0, 0x9b, 0x70, 0x51, 0x9e, 0x53, 0x3b,
+	0x6d, 0x69, 0x51, 0x4a, 0x6f, 0x4b, 0x21, 0xd2, 0xa1, 0xca, 0xb8, 0x53, 0x8e, 0xef, 0x82, 0xc0,
+	0x37, 0xf9, 0xe6, 0x26, 0xb0, 0xb4, 0xda, 0xf6, 0x87, 0x5e, 0x87, 0x04, 0x6a, 0x07, 0x8b, 0x4a,
+	0xfa, 0x7d, 0x21, 0x34, 0xde, 0x84, 0x55, 0x81, 0xe3, 0x6d, 0xdc, 0x77, 0xbb, 0x38, 0xa4, 0xc1,
+	0xa9, 0xcd, 0x5c, 0x85, 0x05, 0x9b, 0xfa, 0xa7, 0x71, 0xcc, 0x73, 0xd9, 0xed, 0xcc, 0xae, 0x3e,
+	0xd5, 0x60, 0x2d, 0xc7, 0x9a, 0xda, 0xd8, 0x36, 0xbc, 0x10, 0xa1, 0x4a, 0x5b, 0x8c, 0xc0, 0x7e,
+	0x85, 0x5b, 0x8b, 0x92, 0xe8, 0x40, 0xc6, 0xf9, 0x59, 0xc2, 0xf3, 0x0a, 0xac, 0xa4, 0x97, 0x16,
+	0x25, 0x91, 0xf1, 0xa6, 0x72, 0xf6, 0x56, 0x48, 0x03, 0xec, 0x14, 0x3b, 0x43, 0x4b, 0x50, 0x3a,
+	0x21, 0x0f, 0x55, 0xbe, 0xf1, 0x61, 0xc2, 0xfd, 0x2e, 0xac, 0xa4, 0x8d, 0x29, 0xf7, 0x2b, 0x30,
+	0x3b, 0xc2, 0xfd, 0x61, 0xe4, 0x5c, 0x7e, 0x18, 0xdf, 0x84, 0x25, 0x95, 0x4a, 0xdd, 0x67, 0xda,
+	0xe4, 0x36, 0x2c, 0x27, 0xd6, 0x29, 0x17, 0x08, 0xca, 0x3c, 0xf7, 0xc5, 0xaa, 0x05, 0x4b, 0x8c,
+	0x8d, 0xf7, 0xd4, 0x8d, 0x3f, 0x1e, 0xdf, 0xa5, 0x0e, 0x8b, 0x5c, 0x20, 0x28, 0x8b, 0x1b, 0x23,
+	0xed, 0x8b, 0x31, 0x7a, 0x0d, 0x20, 0xae, 0x5d, 0x62, 0x6f, 0xf3, 0xfb, 0x5b, 0xd1, 0x95, 0xe7,
+	0x85, 0xae, 0x25, 0xcb, 0xa4, 0x2a, 0x74, 0xad, 0xfb, 0xf1, 0x51, 0x59, 0x89, 0x95, 0x09, 0x90,
+	0x9f, 0x68, 0x70, 0x29, 0xe5, 0x5c, 0xe1, 0xbc, 0x0e, 0xe5, 0x3e, 0x75, 0xf8, 0xee, 0x4a, 0x3b,
+	0xf3, 0xfb, 0x97, 0xb3, 0x65, 0xe5, 0x2e, 0x75, 0x2c, 0xa1, 0x82, 0x8e, 0xa6, 0x80, 0xda, 0x2e,
+	0x04, 0x25, 0xfd, 0x24, 0x51, 0x4d, 0x2a, 0xdf, 0x7d, 0x1c, 0x60, 0x2f, 0x3a, 0x07, 0xc3, 0x82,
+	0x4b, 0x29, 0xa9, 0x02, 0xf8, 0x6d, 0x98, 0x1b, 0x08, 0x89, 0xaa, 0x7c, 0xf5, 0x2c, 0x44, 0xb9,
+	0xe2, 0xa0, 0xf6, 0xc5, 0xe3, 0xe6, 0xcc, 0xaf, 0xff, 0xfe, 0xdb, 0x1b, 0x9a, 0xa5, 0x96, 0x18,
+	0xff, 0xd2, 0xe0, 0xe2, 0x9d, 0xb0, 0x77, 0x88, 0xfb, 0xfd, 0xc4, 0x71, 0xe3, 0xc0, 0x61, 0x51,
+	0x60, 0xf8, 0x18, 0xbd, 0x04, 0x15, 0x07, 0xb3, 0xb6, 0x8d, 0x07, 0xea, 0x8e, 0xcc, 0x39, 0x98,
+	0x1d, 0xe2, 0x01, 0x7a, 0x07, 0x96, 0x06, 0x01, 0x1d, 0x50, 0x46, 0x82, 0xc9, 0x3d, 0xe3, 0x77,
+	0x64, 0xe1, 0x60, 0xff, 0x9f, 0x8f, 0x9b, 0x2d, 0xc7, 0x0d, 0x7b, 0xc3, 0x4e, 0xcb, 0xa6, 0x9e,
+	0xa9, 0x1e, 0x0f, 0xf9, 0xdf, 0xcb, 0xac, 0x7b, 0x62, 0x86, 0x0f, 0x07, 0x84, 0xb5, 0x0e, 0xe3,
+	0x0b, 0x6e, 0xbd, 0x10, 0xd9, 0x8a, 0x2e, 0xe7, 0x15, 0xa8, 0xda, 0xbc, 0x6a, 0xb7, 0xdd, 0x6e,
+	0xbd, 0xbc, 0xae, 0xed, 0x94, 0xac, 0x8a, 0xf8, 0x7e, 0xa3, 0x8b, 0x56, 0xa1, 0x46, 0x47, 0x24,
+	0x08, 0xdc, 0x2e, 0x61, 0xf5, 0x59, 0x81, 0x35, 0x16, 0xf0, 0xeb, 0xdf, 0xe9, 0x53, 0xfb, 0xa4,
+	0x1d, 0xeb, 0xcc, 0x09, 0x9d, 0x8b, 0x42, 0xfc, 0x83, 0x48, 0x6a, 0x1c, 0xc3, 0xa5, 0x3b, 0x2c,
+	0x74, 0x3d, 0x1c, 0x92, 0x23, 0x1c, 0x1f, 0xea, 0x12, 0x94, 0x1c, 0x2c, 0xcf, 0xa0, 0x6c, 0xf1,
+	0x21, 0x97, 0x04, 0x24, 0x14, 0xdb, 0x5f, 0xb0, 0xf8, 0x90, 0x83, 0x1b, 0x79, 0x6d, 0x12, 0x04,
+	0x54, 0xd6, 0x85, 0x9a, 0x55, 0x19, 0x79, 0x77, 0xf8, 0xa7, 0xf1, 0x49, 0x39, 0x4a, 0xa6, 0x00,
+	0xdb, 0xe4, 0x78, 0x1c, 0x9d, 0xed, 0x1e, 0x94, 0x3c, 0x16, 0x3d, 0x51, 0xcd, 0x6c, 0xa0, 0xee,
+	0x31, 0xe7, 0x4e, 0xd8, 0x23, 0x01, 0x19, 0x7a, 0xc7, 0x63, 0x8b, 0xeb, 0xa2, 0xef, 0xc2, 0x42,
+	0xc8, 0x8d, 0xb4, 0xd5, 0xf3, 0x56, 0xca, 0x7b, 0xde, 0x84, 0x2b, 0xf5, 0xbc, 0xcd, 0x87, 0xf1,
+	0x07, 0x3a, 0x84, 0x85, 0x41, 0x40, 0xba, 0xc4, 0x26, 0x8c, 0xd1, 0x80, 0xd5, 0xcb, 0xeb, 0xa5,
+	0xf3, 0x78, 0x4f, 0x2d, 0xe2, 0xe5, 0x59, 0x1e, 0xa8, 0x2a, 0x84, 0xb3, 0x22, 0x1a, 0xf3, 0x42,
+	0x26, 0xcb, 0x20, 0x5a, 0x03, 0x90, 0x2a, 0xe2, 0xb6, 0xce, 0x89, 0x13, 0xa9, 0x09, 0x89, 0x78,
+	0xe0, 0x5e, 0x8f, 0xa6, 0xf9, 0x3b, 0x5f, 0xaf, 0x88, 0x6d, 0xe8, 0x2d, 0x49, 0x02, 0x5a, 0x11,
+	0x09, 0x68, 0x1d, 0x47, 0x24, 0xe0, 0x60, 0x91, 0x67, 0xeb, 0x67, 0x7f, 0x69, 0x6a, 0x32, 0x63,
+	0xa5, 0x25, 0x3e, 0x3d, 0x35, 0xe9, 0xaa, 0xff, 0x9d, 0xa4, 0xab, 0xa5, 0x93, 0xce, 0x80, 0x45,
+	0xb9, 0x07, 0x0f, 0x8f, 0xdb, 0x3c, 0x41, 0x20, 0x71, 0x0c, 0xf7, 0xf0, 0xf8, 0x08, 0xb3, 0xef,
+	0x95, 0xab, 0x17, 0x96, 0x4a, 0x56, 0x35, 0x1c, 0xb7, 0x5d, 0xbf, 0x4b, 0xc6, 0xc6, 0x0d, 0x55,
+	0x63, 0x27, 0xa9, 0x10, 0x17, 0xc0, 0x2e, 0x0e, 0x71, 0x74, 0xcf, 0xf8, 0xd8, 0xf8, 0x5d, 0x09,
+	0x5e, 0x8c, 0x95, 0x0f, 0xb8, 0xd5, 0x44, 0xea, 0x84, 0xe3, 0xa8, 0x0c, 0x15, 0xa7, 0x4e, 0x38,
+	0x66, 0x5f, 0x41, 0xea, 0xfc, 0x3f, 0xea, 0xe7, 0x8c, 0xba, 0xf1, 0x32, 0xbc, 0x94, 0x09, 0xdc,
+	0x19, 0x81, 0xfe, 0x43, 0x09, 0x2e, 0xc7, 0xfa, 0xff, 0xab, 0xe5, 0xf7, 0x74, 0x02, 0x95, 0x9f,
+	0x43, 0x02, 0x1d, 0x3e, 0x63, 0x02, 0x55, 0xa3, 0x04, 0x4a, 0xe6, 0x4e, 0x32, 0xb8, 0xd5, 0x33,
+	0xde, 0x91, 0xda, 0x39, 0xde, 0x11, 0x98, 0xfa, 0x8e, 0xec, 0xc2, 0x8b, 0xa7, 0xe3, 0x79, 0x46,
+	0xf8, 0x2f, 0x4f, 0x18, 0x23, 0x23, 0xaf, 0x11, 0x12, 0xf7, 0x36, 0x2b, 0x69, 0xb1, 0x32, 0xf1,
+	0x2a, 0x54, 0x39, 0x7d, 0x68, 0x3f, 0x20, 0x8a, 0x91, 0x1d, 0x5c, 0xf9, 0xf3, 0xe3, 0xe6, 0x65,
+	0x19, 0x06, 0xd6, 0x3d, 0x69, 0xb9, 0xd4, 0xf4, 0x70, 0xd8, 0x6b, 0xbd, 0xe1, 0x87, 0x9c, 0x29,
+	0x8a, 0xd5, 0x46, 0x53, 0x71, 0xe4, 0xa3, 0x3e, 0xed, 0xe0, 0xfe, 0x3d, 0xd7, 0x3f, 0xc2, 0xec,
+	0x7e, 0xe0, 0x4e, 0x08, 0xaa, 0x61, 0x43, 0x23, 0x4f, 0x41, 0x39, 0xbe, 0x0d, 0x8b, 0x9e, 0xeb,
+	0xf3, 0x9c, 0x6f, 0x0f, 0xf8, 0x84, 0xf2, 0xbe, 0xc6, 0xcf, 0x38, 0x1f, 0xc1, 0xbc, 0x17, 0x9b,
+	0x32, 0x7e, 0xaf, 0xc1, 0xf2, 0x5b, 0xae, 0x37, 0xec, 0xe3, 0x90, 0xbc, 0xbd, 0x97, 0xc8, 0x72,
+	0x3a, 0x08, 0x27, 0x59, 0xce, 0xc7, 0xcf, 0x2d, 0xcb, 0xd3, 0x09, 0x58, 0x3e, 0x95, 0x80, 0xc6,
+	0x3b, 0x80, 0x92, 0xf8, 0xf3, 0xa3, 0xca, 0x59, 0xb3, 0x64, 0x03, 0x92, 0x6b, 0xcb, 0x0f, 0x6e,
+	0x5e, 0x0c, 0xda, 0x82, 0xee, 0x72, 0xdc, 0xb3, 0x56, 0x4d, 0x48, 0x38, 0x1f, 0xde, 0xff, 0xf9,
+	0x32, 0xcc, 0x8a, 0x28, 0xa0, 0x9f, 0x69, 0x50, 0x51, 0x6d, 0x0c, 0xda, 0xcc, 0xde, 0xb1, 0x29,
+	0x7d, 0xaa, 0xbe, 0x55, 0xa4, 0x26, 0xd1, 0x1a, 0x37, 0x7f, 0xf2, 0xc7, 0xbf, 0xfd, 0xe2, 0xc2,
+	0x26, 0xba, 0x66, 0x66, 0x7a, 0x78, 0xd5, 0xca, 0x98, 0xef, 0xab, 0x93, 0x7d, 0x84, 0x7e, 0xa9,
+	0xc1, 0x62, 0xaa, 0x5b, 0x44, 0x37, 0x73, 0xdc, 0x4c, 0xeb, 0x4a, 0xf5, 0xdd, 0xf3, 0x29, 0x2b,
+	0x64, 0xfb, 0x02, 0xd9, 0x2e, 0xba, 0x91, 0x45, 0x16, 0x35, 0xa6, 0x19, 0x80, 0xbf, 0xd1, 0x60,
+	0xe9, 0x74, 0xe3, 0x87, 0x5a, 0x39, 0x6e, 0x73, 0xfa, 0x4d, 0xdd, 0x3c, 0xb7, 0xbe, 0x42, 0x7a,
+	0x4b, 0x20, 0x7d, 0x15, 0xed, 0x67, 0x91, 0x8e, 0xa2, 0x35, 0x31, 0xd8, 0x64, 0x2f, 0xfb, 0x08,
+	0x7d, 0xa4, 0x41, 0x45, 0xb5, 0x78, 0xb9, 0xa1, 0x4d, 0x77, 0x8f, 0xfa, 0x56, 0x91, 0x9a, 0x82,
+	0xb5, 0x2b, 0x60, 0x6d, 0xa1, 0x8d, 0x2c, 0x2c, 0xd5, 0x32, 0xb2, 0xc4, 0xd1, 0x7d, 0xaa, 0x41,
+	0x45, 0x35, 0x7b, 0xb9, 0x40, 0xd2, 0x9d, 0xa5, 0xbe, 0x55, 0xa4, 0xa6, 0x80, 0xec, 0x09, 0x20,
+	0x37, 0xd1, 0xf5, 0x2c, 0x10, 0x26, 0x55, 0x63, 0x1c, 0xe6, 0xfb, 0x27, 0xe4, 0xe1, 0x23, 0xf4,
+	0x1e, 0x94, 0xf9, 0x1d, 0x40, 0x46, 0x6e, 0xca, 0x4c, 0x1a, 0x4d, 0xfd, 0xda, 0x99, 0x3a, 0x0a,
+	0xc3, 0x75, 0x81, 0xe1, 0x1a, 0xba, 0x3a, 0x2d, 0x9b, 0xba, 0xa9, 0x93, 0xf8, 0x31, 0xcc, 0xc9,
+	0xb6, 0x08, 0x6d, 0xe4, 0x58, 0x4e, 0x75, 0x5f, 0xfa, 0x66, 0x81, 0x96, 0x42, 0xb0, 0x2e, 0x10,
+	0xe8, 0xa8, 0x9e, 0x45, 0x20, 0x5b, 0x2e, 0x34, 0x86, 0x8a, 0xea, 0xb8, 0xd0, 0x7a, 0xd6, 0x66,
+	0xba, 0x19, 0xd3, 0xb7, 0x8b, 0x88, 0x5e, 0xe4, 0xd7, 0x10, 0x7e, 0x57, 0x91, 0x9e, 0xf5, 0x4b,
+	0xc2, 0x5e, 0xdb, 0xe6, 0xee, 0x3e, 0x84, 0xf9, 0x44, 0xaf, 0x73, 0x0e, 0xef, 0x53, 0xf6, 0x3c,
+	0xa5, 0x59, 0x32, 0xb6, 0x84, 0xef, 0x75, 0xd4, 0x98, 0xe2, 0x5b, 0xa9, 0xf3, 0x27, 0x04, 0x7d,
+	0x00, 0x15, 0x45, 0x82, 0x73, 0x73, 0x2f, 0xdd, 0x2f, 0xe9, 0x5b, 0x45, 0x6a, 0xc5, 0xbb, 0x97,
+	0x04, 0x26, 0x1c, 0xa3, 0x8f, 0x35, 0x80, 0x98, 0x9d, 0xa1, 0x9d, 0xb3, 0x4c, 0x27, 0x99, 0xb7,
+	0x7e, 0xfd, 0x1c, 0x9a, 0x0a, 0xc7, 0xa6, 0xc0, 0xd1, 0x44, 0x6b, 0x79, 0x38, 0xc4, 0xb3, 0x82,
+	0x7e, 0xaa, 0x41, 0x6d, 0x42, 0x14, 0xd0, 0xf6, 0x59, 0xf6, 0x93, 0xe1, 0xd8, 0x29, 0x56, 0x54,
+	0x38, 0x36, 0x04, 0x8e, 0x06, 0x5a, 0xcd, 0xc3, 0x21, 0xf2, 0xe1, 0x03, 0x5e, 0x94, 0x04, 0x57,
+	0x38, 0xa3, 0x28, 0x25, 0x09, 0x8a, 0xbe, 0x55, 0xa4, 0x56, 0x1c, 0x8f, 0x88, 0xc8, 0xf0, 0x0b,
+	0xa8, 0x48, 0xe2, 0x46, 0xee, 0xd5, 0x4e, 0xfc, 0xe1, 0x57, 0xdf, 0x2c, 0xd0, 0x2a, 0xbe, 0x80,
+	0x92, 0xc5, 0xa2, 0x5f, 0x69, 0xb0, 0x9c, 0xa1, 0x3c, 0x28, 0xef, 0x3d, 0xc8, 0x63, 0x4f, 0xfa,
+	0x2b, 0xe7, 0x5f, 0xa0, 0xa0, 0x6d, 0x0b, 0x68, 0x57, 0x51, 0x33, 0x0b, 0x2d, 0xc5, 0xb2, 0xd0,
+	0x87, 0x00, 0x31, 0xe5, 0x40, 0x53, 0x2a, 0x5f, 0x86, 0x50, 0xe9, 0x1b, 0x67, 0x2b, 0x15, 0xe7,
+	0x27, 0x53, 0xda, 0xed, 0xd1, 0xde, 0xc1, 0xad, 0x1f, 0xad, 0x67, 0x49, 0x15, 0x57, 0x1d, 0x73,
+	0x65, 0x41, 0xa9, 0xbe, 0x78, 0xd2, 0xd0, 0xbe, 0x7c, 0xd2, 0xd0, 0xfe, 0xfa, 0xa4, 0xa1, 0x7d,
+	0xf6, 0xb4, 0x31, 0xf3, 0xe5, 0xd3, 0xc6, 0xcc, 0x9f, 0x9e, 0x36, 0x66, 0x3a, 0x73, 0x82, 0x93,
+	0x7f, 0xfd, 0xdf, 0x03, 0x00, 0xe2, 0xf4, 0x76, 0x09, 0xb9, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1844,6 +2017,8 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 }
 
 type queryClient struct {
@@ -1989,6 +2164,15 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -2029,6 +2213,8 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2080,6 +2266,9 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2355,6 +2544,24 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2419,6 +2626,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -3048,6 +3259,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Overrides) > 0 {
 		i -= len(m.Overrides)
 		copy(dAtA[i:], m.Overrides)
@@ -3388,6 +3606,20 @@ func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -3591,6 +3823,97 @@ func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorCode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3883,6 +4206,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4045,6 +4372,14 @@ func (m *QueryTraceCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -4103,22 +4438,66 @@ func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovQuery(uint64(m.ErrorCode))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5930,6 +6309,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7097,6 +7510,74 @@ func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7472,6 +7953,310 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
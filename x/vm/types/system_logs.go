@@ -0,0 +1,80 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// evmExecutionKey is the context key marking the execution of EVM transactions.
+type evmExecutionKey struct{}
+
+// WithEVMExecution marks the context as executing an EVM transaction or
+// message. The state changes made with it are already reflected by the logs
+// of the EVM, so no system log should be emitted for them.
+func WithEVMExecution(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(evmExecutionKey{}, true)
+}
+
+// IsEVMExecution returns true if the context executes an EVM transaction or
+// message.
+func IsEVMExecution(goCtx context.Context) bool {
+	executing, _ := sdk.UnwrapSDKContext(goCtx).Value(evmExecutionKey{}).(bool)
+	return executing
+}
+
+// precompileLogsKey is the context key of the function adding the EVM logs of
+// the precompile being executed.
+type precompileLogsKey struct{}
+
+// WithPrecompileLogs sets the function adding EVM logs to the transaction
+// executing the precompile run with the context. The state changes made by the
+// precompile outside of the EVM are reported with EVM logs instead of system
+// logs. A nil function marks them as already reported by the precompile.
+func WithPrecompileLogs(ctx sdk.Context, addLog func(*ethtypes.Log)) sdk.Context {
+	return ctx.WithValue(precompileLogsKey{}, addLog)
+}
+
+// PrecompileLogs returns the function adding the EVM logs of the precompile
+// executed with the context, nil outside of a precompile.
+func PrecompileLogs(goCtx context.Context) func(*ethtypes.Log) {
+	addLog, _ := sdk.UnwrapSDKContext(goCtx).Value(precompileLogsKey{}).(func(*ethtypes.Log))
+	return addLog
+}
+
+// NewSystemLogEvent returns the event carrying a system log, i.e. an EVM log
+// emitted for a state change made outside of the EVM.
+func NewSystemLogEvent(log *ethtypes.Log) (sdk.Event, error) {
+	bz, err := json.Marshal(log)
+	if err != nil {
+		return sdk.Event{}, err
+	}
+	return sdk.NewEvent(EventTypeSystemLog, sdk.NewAttribute(AttributeKeyTxLog, string(bz))), nil
+}
+
+// DecodeSystemLogs returns the system logs carried by the events of a
+// transaction result.
+func DecodeSystemLogs(events []abci.Event) ([]*ethtypes.Log, error) {
+	var logs []*ethtypes.Log
+	for _, event := range events {
+		if event.Type != EventTypeSystemLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != AttributeKeyTxLog {
+				continue
+			}
+			var log ethtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &log)
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSystemLogs(t *testing.T) {
+	log := &ethtypes.Log{
+		Address:     common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Topics:      []common.Hash{common.BytesToHash([]byte("topic"))},
+		Data:        []byte("data"),
+		BlockNumber: 10,
+		TxHash:      common.BytesToHash([]byte("tx_hash")),
+		Index:       3,
+		BlockHash:   common.BytesToHash([]byte("block_hash")),
+	}
+
+	event, err := types.NewSystemLogEvent(log)
+	require.NoError(t, err)
+
+	events := sdk.Events{
+		sdk.NewEvent("other", sdk.NewAttribute(types.AttributeKeyTxLog, "invalid")),
+		event,
+	}.ToABCIEvents()
+	logs, err := types.DecodeSystemLogs(events)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{log}, logs)
+
+	_, err = types.DecodeSystemLogs([]abci.Event{{
+		Type:       types.EventTypeSystemLog,
+		Attributes: []abci.EventAttribute{{Key: types.AttributeKeyTxLog, Value: "invalid"}},
+	}})
+	require.Error(t, err)
+}
+
+func TestEVMExecution(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.False(t, types.IsEVMExecution(ctx))
+
+	ctx = types.WithEVMExecution(ctx)
+	require.True(t, types.IsEVMExecution(ctx))
+	// the mark is kept by the derived contexts
+	require.True(t, types.IsEVMExecution(ctx.WithBlockHeight(2)))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -237,3 +238,23 @@ func GetBaseFee(height int64, ethCfg *params.ChainConfig, feemarketParams *feema
 	}
 	return ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
+
+// FeeAmountInEVMDenom returns the value of the fees in the EVM denom. The fees
+// paid in other denoms are converted by the oracle, the denoms it doesn't
+// accept are ignored. Only the EVM denom is valued if the oracle is nil.
+func FeeAmountInEVMDenom(ctx sdk.Context, oracle FeeDenomOracle, evmDenom string, fees sdk.Coins) math.Int {
+	amount := math.ZeroInt()
+	for _, coin := range fees {
+		if coin.Denom == evmDenom {
+			amount = amount.Add(coin.Amount)
+			continue
+		}
+		if oracle == nil {
+			continue
+		}
+		if converted, ok := oracle.ConvertToEVMDenom(ctx, coin); ok && converted.IsPositive() {
+			amount = amount.Add(converted)
+		}
+	}
+	return amount
+}
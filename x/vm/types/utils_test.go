@@ -14,6 +14,8 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	proto "github.com/cosmos/gogoproto/proto"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -547,3 +549,24 @@ func TestDecodeTxLogs(t *testing.T) {
 		})
 	}
 }
+
+type mockFeeDenomOracle struct{}
+
+func (mockFeeDenomOracle) ConvertToEVMDenom(_ sdk.Context, coin sdk.Coin) (sdkmath.Int, bool) {
+	if coin.Denom != "uusdc" {
+		return sdkmath.Int{}, false
+	}
+	return coin.Amount.MulRaw(3), true
+}
+
+func TestFeeAmountInEVMDenom(t *testing.T) {
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("aevm", 10),
+		sdk.NewInt64Coin("uusdc", 5),
+		sdk.NewInt64Coin("uatom", 7),
+	)
+
+	require.Equal(t, sdkmath.NewInt(10), evmtypes.FeeAmountInEVMDenom(sdk.Context{}, nil, "aevm", fees))
+	require.Equal(t, sdkmath.NewInt(25), evmtypes.FeeAmountInEVMDenom(sdk.Context{}, mockFeeDenomOracle{}, "aevm", fees))
+	require.True(t, evmtypes.FeeAmountInEVMDenom(sdk.Context{}, mockFeeDenomOracle{}, "aevm", nil).IsZero())
+}